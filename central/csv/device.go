@@ -0,0 +1,146 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package csv
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/central/xlsx"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// deviceCSV converts the devices CSV file to Device DTOs. The CSV files are converted through the Devices,
+// MappingTable and AutoEvents sheets of an xlsx file, so the rows, columns and mappings have the same semantics as the
+// xlsx file, and the validate diagnostics locate the cells of these sheets.
+type deviceCSV struct {
+	xlsx.Converter[[]*dtos.Device]
+	devices    io.Reader
+	mapping    io.Reader
+	autoEvents io.Reader
+}
+
+var (
+	_ xlsx.Converter[[]*dtos.Device] = (*deviceCSV)(nil)
+	_ xlsx.DiagnosticsConverter      = (*deviceCSV)(nil)
+)
+
+// ConvertDeviceCSV converts the devices CSV file to Device DTOs. The header of the devices CSV file has the Device
+// field names, the Objects of the mapping file, or the paths of the Device maps prefixed by protocols, properties or
+// tags, e.g. protocols.modbus-rtu.Address. The mapping file has the same columns as the MappingTable sheet, and the
+// autoEvents CSV file has the same columns as the AutoEvents sheet, both of them are optional and can be nil.
+func ConvertDeviceCSV(devices, mapping, autoEvents io.Reader) (xlsx.Converter[[]*dtos.Device], errors.EdgeX) {
+	deviceC := NewDeviceCSV(devices, mapping, autoEvents)
+	err := deviceC.ConvertToDTO()
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return deviceC, nil
+}
+
+// NewDeviceCSV creates the Converter of the CSV files without converting them, so the validate diagnostics can still
+// be read if ConvertToDTO fails
+func NewDeviceCSV(devices, mapping, autoEvents io.Reader) xlsx.Converter[[]*dtos.Device] {
+	return &deviceCSV{devices: devices, mapping: mapping, autoEvents: autoEvents}
+}
+
+// ConvertToDTO parses the CSV files and convert the rows to Device DTOs
+func (deviceC *deviceCSV) ConvertToDTO() errors.EdgeX {
+	records, err := readCSV(deviceC.devices, devicesSheetName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(records) < 2 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("at least 2 rows need to be defined in the %s CSV file", devicesSheetName), nil)
+	}
+
+	table, err := readMappingTable(deviceC.mapping)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	records[0], err = table.toSheetHeader(records[0])
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(table.rows) == 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			"no mapping defined, either provide the mapping file or prefix the header with protocols, properties or tags", nil)
+	}
+
+	sheets := map[string][][]string{
+		devicesSheetName:      records,
+		mappingTableSheetName: table.sheetRows(),
+	}
+	if deviceC.autoEvents != nil {
+		sheets[autoEventsSheetName], err = readCSV(deviceC.autoEvents, autoEventsSheetName)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	file, err := newWorkbook(sheets)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	buffer, writeErr := file.WriteToBuffer()
+	_ = file.Close()
+	if writeErr != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to write the xlsx file converted from the CSV files", writeErr)
+	}
+
+	deviceC.Converter, err = xlsx.NewDeviceXlsx(buffer)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return deviceC.Converter.ConvertToDTO()
+}
+
+func (deviceC *deviceCSV) GetDTOs() []*dtos.Device {
+	if deviceC.Converter == nil {
+		return nil
+	}
+	return deviceC.Converter.GetDTOs()
+}
+
+func (deviceC *deviceCSV) GetValidateErrors() map[string]error {
+	if deviceC.Converter == nil {
+		return map[string]error{}
+	}
+	return deviceC.Converter.GetValidateErrors()
+}
+
+func (deviceC *deviceCSV) GetValidateDiagnostics() []xlsx.CellDiagnostic {
+	converter, ok := deviceC.Converter.(xlsx.DiagnosticsConverter)
+	if !ok {
+		return nil
+	}
+	return converter.GetValidateDiagnostics()
+}
+
+// newWorkbook creates the xlsx file with the sheets of rows
+func newWorkbook(sheets map[string][][]string) (*excelize.File, errors.EdgeX) {
+	f := excelize.NewFile()
+	for name, rows := range sheets {
+		if _, err := f.NewSheet(name); err != nil {
+			_ = f.Close()
+			return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to create %s worksheet", name), err)
+		}
+		for i, row := range rows {
+			cells := make([]any, len(row))
+			for j, cell := range row {
+				cells[j] = cell
+			}
+			cellName, _ := excelize.CoordinatesToCellName(1, i+1)
+			if err := f.SetSheetRow(name, cellName, &cells); err != nil {
+				_ = f.Close()
+				return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to set row %d of %s worksheet", i+1, name), err)
+			}
+		}
+	}
+	return f, nil
+}
@@ -0,0 +1,147 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package csv
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/central/xlsx"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockMapping = `Object,Path,Default Value
+AdminState,adminState,UNLOCKED
+OperatingState,operatingState,UP
+ProtocolName,properties.IOTech_ProtocolName,modbus-tcp
+Interval,autoEvents[].interval,1s
+Address,protocols.modbus-tcp.Address,
+Port,protocols.modbus-tcp.Port,502
+`
+	mockDevices = `Name,ServiceName,ProfileName,Labels,Address,tags.MachineType
+Sensor1,device-modbus,tcp-profile,"a,b",192.168.0.1,Motor
+Sensor2,device-modbus,tcp-profile,,192.168.0.2,
+`
+	mockAutoEvents = `Interval,OnChange,SourceName,Reference Device Name
+5s,true,temperature,Sensor1
+`
+)
+
+func TestConvertDeviceCSV(t *testing.T) {
+	converter, err := ConvertDeviceCSV(strings.NewReader(mockDevices), strings.NewReader(mockMapping), strings.NewReader(mockAutoEvents))
+	require.NoError(t, err)
+	require.Empty(t, converter.GetValidateErrors())
+
+	devices := converter.GetDTOs()
+	require.Len(t, devices, 2)
+	assert.Equal(t, "Sensor1", devices[0].Name)
+	assert.Equal(t, []string{"a", "b"}, devices[0].Labels)
+	assert.Equal(t, "UNLOCKED", devices[0].AdminState)
+	assert.Equal(t, "192.168.0.1", devices[0].Protocols["modbus-tcp"][common.ModbusAddress])
+	// the properties are converted by the protocol schema as the xlsx file does
+	assert.Equal(t, 502, devices[0].Protocols["modbus-tcp"][common.ModbusPort])
+	assert.Equal(t, "modbus-tcp", devices[0].Properties[common.ProtocolName])
+	assert.Equal(t, map[string]any{"MachineType": "Motor"}, devices[0].Tags)
+	assert.Equal(t, []dtos.AutoEvent{{Interval: "5s", OnChange: true, SourceName: "temperature"}}, devices[0].AutoEvents)
+	assert.Nil(t, devices[1].Tags)
+	assert.Nil(t, devices[1].AutoEvents)
+}
+
+func TestConvertDeviceCSV_Diagnostics(t *testing.T) {
+	devices := `Name,ServiceName,ProfileName,AdminState,OperatingState,protocols.modbus-tcp.Address
+Sensor1,device-modbus,tcp-profile,BROKEN,UP,192.168.0.1
+`
+	converter := NewDeviceCSV(strings.NewReader(devices), nil, nil)
+	require.NoError(t, converter.ConvertToDTO())
+	assert.Empty(t, converter.GetDTOs())
+	require.Implements(t, (*xlsx.DiagnosticsConverter)(nil), converter)
+	diagnostics := converter.(xlsx.DiagnosticsConverter).GetValidateDiagnostics()
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, "D2", diagnostics[0].Cell())
+	assert.Equal(t, "AdminState", diagnostics[0].Header)
+}
+
+func TestConvertDeviceCSV_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		devices string
+		mapping string
+	}{
+		{"no data row", "Name,protocols.modbus-tcp.Address\n", ""},
+		{"no mapping", "Name,Address\nSensor1,192.168.0.1\n", ""},
+		{"invalid mapping header", "Name,Address\nSensor1,192.168.0.1\n", "Object,Default Value\nAddress,\n"},
+		{"conflicting column", "Name,tags.MachineType\nSensor1,Motor\n", "Object,Path,Default Value\nMachineType,tags.Type,\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mapping *strings.Reader
+			if tt.mapping != "" {
+				mapping = strings.NewReader(tt.mapping)
+			}
+			_, err := ConvertDeviceCSV(strings.NewReader(tt.devices), readerOrNil(mapping), nil)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestConvertToCSV(t *testing.T) {
+	converter, err := ConvertDeviceCSV(strings.NewReader(mockDevices), strings.NewReader(mockMapping), strings.NewReader(mockAutoEvents))
+	require.NoError(t, err)
+	var devices []dtos.Device
+	for _, device := range converter.GetDTOs() {
+		devices = append(devices, *device)
+	}
+
+	// without the mapping file, the Device maps are written to the prefixed columns
+	var devicesCSV, autoEventsCSV bytes.Buffer
+	require.NoError(t, ConvertToCSV(nil, &devicesCSV, &autoEventsCSV, devices))
+	assert.True(t, strings.HasPrefix(devicesCSV.String(),
+		"Name,Description,ServiceName,ProfileName,Labels,AdminState,OperatingState,properties.IOTech_ProtocolName,protocols.modbus-tcp.Address,protocols.modbus-tcp.Port,tags.MachineType\n"))
+
+	converted, err := ConvertDeviceCSV(&devicesCSV, nil, &autoEventsCSV)
+	require.NoError(t, err)
+	require.Len(t, converted.GetDTOs(), len(devices))
+	for i, device := range converted.GetDTOs() {
+		assert.Equal(t, devices[i].Name, device.Name)
+		assert.Equal(t, devices[i].Labels, device.Labels)
+		assert.Equal(t, devices[i].Tags, device.Tags)
+		assert.Equal(t, devices[i].AutoEvents, device.AutoEvents)
+		assert.Equal(t, devices[i].Protocols["modbus-tcp"][common.ModbusAddress], device.Protocols["modbus-tcp"][common.ModbusAddress])
+	}
+
+	// with the mapping file, the columns are the mapping objects and the paths which are not mapped
+	devicesCSV.Reset()
+	require.NoError(t, ConvertToCSV(strings.NewReader(mockMapping), &devicesCSV, nil, devices))
+	assert.True(t, strings.HasPrefix(devicesCSV.String(),
+		"Name,Description,ServiceName,ProfileName,Labels,AdminState,OperatingState,ProtocolName,Address,Port,tags.MachineType\n"))
+
+	converted, err = ConvertDeviceCSV(&devicesCSV, strings.NewReader(mockMapping), nil)
+	require.NoError(t, err)
+	require.Len(t, converted.GetDTOs(), len(devices))
+	assert.Equal(t, devices[0].Tags, converted.GetDTOs()[0].Tags)
+
+	// a protocols.*.<property> mapping covers the property of the device protocol
+	wildcardMapping := strings.Replace(mockMapping, "protocols.modbus-tcp.Port", "protocols.*.Port", 1)
+	devicesCSV.Reset()
+	require.NoError(t, ConvertToCSV(strings.NewReader(wildcardMapping), &devicesCSV, nil, devices))
+	assert.True(t, strings.HasPrefix(devicesCSV.String(),
+		"Name,Description,ServiceName,ProfileName,Labels,AdminState,OperatingState,ProtocolName,Address,Port,tags.MachineType\n"))
+}
+
+// readerOrNil avoids passing a typed nil pointer as a non-nil io.Reader
+func readerOrNil(r *strings.Reader) io.Reader {
+	if r == nil {
+		return nil
+	}
+	return r
+}
@@ -0,0 +1,180 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package csv
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/central/xlsx"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// devicesCSVWriter stores the Device DTOs and the converted devices and autoEvents CSV records
+type devicesCSVWriter struct {
+	devices       []dtos.Device
+	mapping       io.Reader
+	deviceRows    [][]string
+	autoEventRows [][]string
+}
+
+var _ xlsx.DTOConverter[[]dtos.Device] = (*devicesCSVWriter)(nil)
+
+func newDevicesCSVWriter(mapping io.Reader, devices []dtos.Device) *devicesCSVWriter {
+	return &devicesCSVWriter{devices: devices, mapping: mapping}
+}
+
+// ConvertToCSV converts the Device DTOs to the devices CSV file written to w, and the autoEvents CSV file written to
+// autoEventsWriter if it is not nil. The columns of the devices CSV file are the Device fields, the Objects of the
+// mapping file and the prefixed paths of the Device maps which are not mapped by the mapping file, which can be read
+// back by ConvertDeviceCSV.
+func ConvertToCSV(mapping io.Reader, w, autoEventsWriter io.Writer, devices []dtos.Device) errors.EdgeX {
+	csvWriter := newDevicesCSVWriter(mapping, devices)
+	defer func() { _ = csvWriter.Close() }()
+
+	edgexErr := csvWriter.ConvertToXlsx()
+	if edgexErr != nil {
+		return edgexErr
+	}
+
+	edgexErr = csvWriter.Write(w)
+	if edgexErr != nil {
+		return edgexErr
+	}
+	if autoEventsWriter != nil {
+		return csvWriter.WriteAutoEvents(autoEventsWriter)
+	}
+	return nil
+}
+
+// ConvertToXlsx converts the Device DTOs into the Devices and AutoEvents sheets of an xlsx file, and keeps the rows of
+// the sheets as the CSV records
+func (csvWriter *devicesCSVWriter) ConvertToXlsx() errors.EdgeX {
+	table, edgexErr := readMappingTable(csvWriter.mapping)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
+	header := slices.Clone(deviceFieldHeader)
+	for _, row := range table.rows {
+		if !strings.HasPrefix(strings.ToLower(row[1]), "autoevents") && !slices.Contains(header, row[0]) {
+			header = append(header, row[0])
+		}
+	}
+	// the paths which are not mapped are kept as prefixed columns, so that no value is lost
+	for _, path := range devicePaths(csvWriter.devices) {
+		if !table.covers(path) {
+			header = append(header, path)
+		}
+	}
+	sheetHeader, edgexErr := table.toSheetHeader(header)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
+	template, edgexErr := newWorkbook(map[string][][]string{
+		devicesSheetName:      {sheetHeader},
+		mappingTableSheetName: table.sheetRows(),
+		autoEventsSheetName:   {autoEventsHeader},
+	})
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	templateBuffer, err := template.WriteToBuffer()
+	_ = template.Close()
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to write the xlsx template of the CSV files", err)
+	}
+
+	var converted bytes.Buffer
+	edgexErr = xlsx.ConvertToXlsx(templateBuffer, &converted, csvWriter.devices)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	f, err := excelize.OpenReader(&converted)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to open the xlsx file converted from the devices", err)
+	}
+	defer f.Close()
+
+	csvWriter.deviceRows, edgexErr = sheetRecords(f, devicesSheetName, len(header))
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	// the CSV header keeps the prefixed paths replaced in the sheet header
+	csvWriter.deviceRows[0] = header
+	csvWriter.autoEventRows, edgexErr = sheetRecords(f, autoEventsSheetName, len(autoEventsHeader))
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	return nil
+}
+
+// Write writes the devices CSV file to io.Writer
+func (csvWriter *devicesCSVWriter) Write(w io.Writer) errors.EdgeX {
+	return writeCSV(w, csvWriter.deviceRows, devicesSheetName)
+}
+
+// WriteAutoEvents writes the autoEvents CSV file to io.Writer
+func (csvWriter *devicesCSVWriter) WriteAutoEvents(w io.Writer) errors.EdgeX {
+	return writeCSV(w, csvWriter.autoEventRows, autoEventsSheetName)
+}
+
+// Close releases the converted CSV records
+func (csvWriter *devicesCSVWriter) Close() errors.EdgeX {
+	csvWriter.deviceRows = nil
+	csvWriter.autoEventRows = nil
+	return nil
+}
+
+// sheetRecords returns the rows of the sheet with the given number of fields
+func sheetRecords(f *excelize.File, sheetName string, fieldCount int) ([][]string, errors.EdgeX) {
+	rows, err := f.GetRows(sheetName)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to retrieve all rows from %s worksheet", sheetName), err)
+	}
+	for i, row := range rows {
+		// GetRows skips the continually blank cells in the tail of each row
+		if len(row) < fieldCount {
+			rows[i] = append(row, make([]string, fieldCount-len(row))...)
+		}
+	}
+	return rows, nil
+}
+
+// devicePaths returns the sorted prefixed paths of the protocol properties, properties and tags of the devices
+func devicePaths(devices []dtos.Device) []string {
+	paths := make(map[string]struct{})
+	for _, device := range devices {
+		for protocol, protocolProperties := range device.Protocols {
+			addMapPaths(paths, "protocols"+xlsx.MappingPathSeparator+protocol, protocolProperties)
+		}
+		addMapPaths(paths, "properties", device.Properties)
+		addMapPaths(paths, "tags", device.Tags)
+	}
+	return slices.Sorted(maps.Keys(paths))
+}
+
+func addMapPaths(paths map[string]struct{}, prefix string, values map[string]any) {
+	for name, value := range values {
+		path := prefix + xlsx.MappingPathSeparator + name
+		switch v := value.(type) {
+		case map[string]any:
+			addMapPaths(paths, path, v)
+		case dtos.ProtocolProperties:
+			addMapPaths(paths, path, v)
+		default:
+			paths[path] = struct{}{}
+		}
+	}
+}
@@ -0,0 +1,152 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package csv
+
+import (
+	gocsv "encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/central/xlsx"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// constants relates to the xlsx stylesheet names the CSV files are converted through
+const (
+	devicesSheetName      = "Devices"
+	mappingTableSheetName = "MappingTable"
+	autoEventsSheetName   = "AutoEvents"
+)
+
+// constants relates to the header names
+var (
+	mappingTableHeader = []string{"Object", "Path", "Default Value"}
+	autoEventsHeader   = []string{"Interval", "OnChange", "SourceName", "Reference Device Name"}
+	deviceFieldHeader  = []string{"Name", "Description", "ServiceName", "ProfileName", "Labels", "AdminState", "OperatingState"}
+)
+
+// mappingPrefixes are the prefixes of the header paths which are mapped to the Device maps without a mapping file,
+// e.g. the protocols.modbus-rtu.Address column is the Address property of the modbus-rtu protocol
+var mappingPrefixes = []string{"protocols", "properties", "tags"}
+
+// readCSV reads all the records of the CSV file, the records may have different numbers of fields
+func readCSV(r io.Reader, name string) ([][]string, errors.EdgeX) {
+	reader := gocsv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to read the %s CSV file", name), err)
+	}
+	return records, nil
+}
+
+// writeCSV writes the records to the CSV file
+func writeCSV(w io.Writer, records [][]string, name string) errors.EdgeX {
+	writer := gocsv.NewWriter(w)
+	if err := writer.WriteAll(records); err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to write the %s CSV file", name), err)
+	}
+	return nil
+}
+
+// mappingTable stores the rows of the MappingTable sheet, which are read from the mapping file and derived from the
+// prefixed header paths
+type mappingTable struct {
+	rows [][]string
+}
+
+// readMappingTable reads the mapping file, which has the same Object, Path and Default Value columns as the
+// MappingTable sheet. The mapping file is optional, so a nil reader returns an empty mapping table.
+func readMappingTable(r io.Reader) (*mappingTable, errors.EdgeX) {
+	table := &mappingTable{}
+	if r == nil {
+		return table, nil
+	}
+	records, err := readCSV(r, mappingTableSheetName)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(records) == 0 {
+		return table, nil
+	}
+	for i, col := range mappingTableHeader {
+		if i >= len(records[0]) || !strings.EqualFold(strings.TrimSpace(records[0][i]), col) {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("the header of the mapping file should be %s", strings.Join(mappingTableHeader, ", ")), nil)
+		}
+	}
+	for _, record := range records[1:] {
+		row := make([]string, len(mappingTableHeader))
+		copy(row, record)
+		table.rows = append(table.rows, row)
+	}
+	return table, nil
+}
+
+func (t *mappingTable) object(path string) (string, bool) {
+	for _, row := range t.rows {
+		if strings.EqualFold(row[1], path) {
+			return row[0], true
+		}
+	}
+	return "", false
+}
+
+// covers returns whether the prefixed path of a Device map is mapped by the table, where a protocols.*.<property> path
+// maps the property of any protocol
+func (t *mappingTable) covers(path string) bool {
+	for _, row := range t.rows {
+		if strings.EqualFold(row[1], path) {
+			return true
+		}
+		prefix, rest, _ := strings.Cut(row[1], xlsx.MappingPathSeparator)
+		wildcard, property, ok := strings.Cut(rest, xlsx.MappingPathSeparator)
+		if !ok || wildcard != xlsx.MappingPathWildcard || !strings.EqualFold(prefix, mappingPrefixes[0]) {
+			continue
+		}
+		pathPrefix, pathRest, _ := strings.Cut(path, xlsx.MappingPathSeparator)
+		_, pathProperty, ok := strings.Cut(pathRest, xlsx.MappingPathSeparator)
+		if ok && strings.EqualFold(pathPrefix, prefix) && pathProperty == property {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *mappingTable) contains(object string) bool {
+	return slices.ContainsFunc(t.rows, func(row []string) bool { return row[0] == object })
+}
+
+// toSheetHeader returns the header of the sheet for the CSV header. A prefixed header path is replaced by the Object
+// of the path in the mapping file, or otherwise by the path without its prefix, which is added to the mapping table.
+func (t *mappingTable) toSheetHeader(header []string) ([]string, errors.EdgeX) {
+	sheetHeader := make([]string, len(header))
+	for i, cell := range header {
+		cell = strings.TrimSpace(cell)
+		sheetHeader[i] = cell
+		prefix, name, ok := strings.Cut(cell, xlsx.MappingPathSeparator)
+		if !ok || !slices.Contains(mappingPrefixes, strings.ToLower(prefix)) {
+			continue
+		}
+		if object, ok := t.object(cell); ok {
+			sheetHeader[i] = object
+			continue
+		}
+		if t.contains(name) {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("column %s conflicts with the mapping object %s", cell, name), nil)
+		}
+		t.rows = append(t.rows, []string{name, cell, ""})
+		sheetHeader[i] = name
+	}
+	return sheetHeader, nil
+}
+
+func (t *mappingTable) sheetRows() [][]string {
+	return append([][]string{mappingTableHeader}, t.rows...)
+}
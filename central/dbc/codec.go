@@ -0,0 +1,260 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dbc
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// maxFrameLength is the maximum data length of a CAN FD frame
+const maxFrameLength = 64
+
+// Frame is a CAN or CAN FD frame, the ID is the CAN ID without the extended flag of the DBC message ID
+type Frame struct {
+	ID   uint32
+	Data []byte
+}
+
+// Codec decodes the CAN frames of the message described by a profile converted by ConvertDBCtoProfile into events,
+// and encodes the write values of its resources into frames
+type Codec struct {
+	profile dtos.DeviceProfile
+	message *descriptor.Message
+}
+
+// NewCodec creates the Codec of the message described by the profile
+func NewCodec(profile dtos.DeviceProfile) (*Codec, error) {
+	m, err := toDBCMessage(profile)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to read the CAN message of profile %s", profile.Name), err)
+	}
+	if m.size > maxFrameLength {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("message size %d of profile %s exceeds %d bytes", m.size, profile.Name, maxFrameLength), nil)
+	}
+
+	message := &descriptor.Message{
+		Name:        m.name,
+		ID:          m.id &^ messageIDExtendedFlag,
+		IsExtended:  m.id&messageIDExtendedFlag != 0,
+		Length:      uint8(m.size),
+		Description: m.description,
+		SenderNode:  m.sender,
+	}
+	for _, s := range m.signals {
+		if s.length == 0 || s.length > 64 || !bitRangeInFrame(s.start, s.length, !s.littleEndian, m.size) {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("signal %s of profile %s does not fit into %d bytes", s.name, profile.Name, m.size), nil)
+		}
+		if s.scale == 0 {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("signal %s of profile %s has a zero scale", s.name, profile.Name), nil)
+		}
+		message.Signals = append(message.Signals, &descriptor.Signal{
+			Name:             s.name,
+			Start:            uint8(s.start),
+			Length:           uint8(s.length),
+			IsBigEndian:      !s.littleEndian,
+			IsSigned:         s.signed,
+			IsMultiplexer:    s.multiplexer,
+			IsMultiplexed:    s.multiplexed,
+			MultiplexerValue: uint(s.multiplexerValue),
+			Offset:           s.offset,
+			Scale:            s.scale,
+			Min:              s.minimum,
+			Max:              s.maximum,
+			Unit:             s.unit,
+			DefaultValue:     int(s.defaultValue),
+		})
+	}
+	return &Codec{profile: profile, message: message}, nil
+}
+
+// Decode decodes the frame into an event of the device, the readings are the physical values of the signals. Only
+// the multiplexed signals selected by the multiplexer value of the frame are read.
+func (c *Codec) Decode(deviceName string, frame Frame) (dtos.Event, error) {
+	if frame.ID != c.message.ID {
+		return dtos.Event{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("frame ID %d is not the ID %d of message %s", frame.ID, c.message.ID, c.message.Name), nil)
+	}
+	if len(frame.Data) < int(c.message.Length) {
+		return dtos.Event{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("frame has %d bytes but message %s has %d bytes", len(frame.Data), c.message.Name, c.message.Length), nil)
+	}
+
+	var muxValue uint64
+	multiplexer, multiplexed := c.message.MultiplexerSignal()
+	if multiplexed {
+		muxValue = unsignedBits(frame.Data, multiplexer)
+	}
+
+	event := dtos.NewEvent(c.profile.Name, deviceName, c.profile.Name)
+	for _, signal := range c.message.Signals {
+		if signal.IsMultiplexed && (!multiplexed || uint64(signal.MultiplexerValue) != muxValue) {
+			continue
+		}
+		var raw float64
+		if signal.IsSigned {
+			raw = float64(signedBits(frame.Data, signal))
+		} else {
+			raw = float64(unsignedBits(frame.Data, signal))
+		}
+
+		valueType := c.valueType(signal)
+		value, err := common.ParseValueByDeviceResource(valueType, strconv.FormatFloat(signal.ToPhysical(raw), 'f', -1, 64))
+		if err != nil {
+			return dtos.Event{}, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to decode signal %s", signal.Name), err)
+		}
+		if err := event.AddSimpleReading(signal.Name, valueType, value); err != nil {
+			return dtos.Event{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to decode signal %s", signal.Name), err)
+		}
+	}
+	return event, nil
+}
+
+// Encode packs the physical write values, keyed by resource name, into a frame of the message. The signals without a
+// value are set to their default value, and values outside the minimum and maximum of the resource are rejected.
+// A multiplexed message is encoded for the multiplexer value, and only the signals it selects may have values.
+func (c *Codec) Encode(values map[string]any) (Frame, error) {
+	frame := Frame{ID: c.message.ID, Data: make([]byte, c.message.Length)}
+	for name := range values {
+		if !slices.ContainsFunc(c.message.Signals, func(s *descriptor.Signal) bool { return s.Name == name }) {
+			return Frame{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s is not a signal of message %s", name, c.message.Name), nil)
+		}
+	}
+
+	var muxValue uint64
+	multiplexer, multiplexed := c.message.MultiplexerSignal()
+	if multiplexed {
+		raw, err := rawValue(multiplexer, values)
+		if err != nil {
+			return Frame{}, errors.NewCommonEdgeXWrapper(err)
+		}
+		muxValue = uint64(raw)
+	}
+
+	for _, signal := range c.message.Signals {
+		if signal.IsMultiplexed && (!multiplexed || uint64(signal.MultiplexerValue) != muxValue) {
+			if _, ok := values[signal.Name]; ok {
+				return Frame{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("signal %s is not selected by the multiplexer value %d", signal.Name, muxValue), nil)
+			}
+			continue
+		}
+		raw, err := rawValue(signal, values)
+		if err != nil {
+			return Frame{}, errors.NewCommonEdgeXWrapper(err)
+		}
+		setBits(frame.Data, signal, uint64(raw))
+	}
+	return frame, nil
+}
+
+// valueType returns the value type of the resource of the signal. A Uint64 resource of a signal whose physical value
+// may be negative, e.g. of a profile converted before the negative offsets were read as Int64, is decoded as Int64.
+func (c *Codec) valueType(signal *descriptor.Signal) string {
+	for _, resource := range c.profile.DeviceResources {
+		if resource.Name != signal.Name {
+			continue
+		}
+		if resource.Properties.ValueType == common.ValueTypeUint64 && mayBeNegative(signal) {
+			return common.ValueTypeInt64
+		}
+		return resource.Properties.ValueType
+	}
+	return common.ValueTypeFloat64
+}
+
+// rawValue returns the raw value of the signal for the physical value, or the default value if there is no value
+func rawValue(signal *descriptor.Signal, values map[string]any) (int64, errors.EdgeX) {
+	value, ok := values[signal.Name]
+	if !ok {
+		return int64(signal.DefaultValue), nil
+	}
+	physical, err := strconv.ParseFloat(fmt.Sprintf("%v", value), 64)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("value '%v' of signal %s is not a number", value, signal.Name), err)
+	}
+	if (signal.Min != 0 || signal.Max != 0) && (physical < signal.Min || physical > signal.Max) {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("value %v of signal %s is out of range [%v, %v]", value, signal.Name, signal.Min, signal.Max), nil)
+	}
+
+	raw := math.Round((physical - signal.Offset) / signal.Scale)
+	minRaw, maxRaw := 0.0, float64(signal.MaxUnsigned())
+	if signal.IsSigned {
+		minRaw, maxRaw = float64(signal.MinSigned()), float64(signal.MaxSigned())
+	}
+	if raw < minRaw || raw > maxRaw {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("value %v of signal %s does not fit into %d bits", value, signal.Name, signal.Length), nil)
+	}
+	if raw > math.MaxInt64 {
+		return int64(uint64(raw)), nil
+	}
+	return int64(raw), nil
+}
+
+// bitPositions returns the frame bit positions of the signal from its least significant bit. The start bit of a
+// little-endian signal is its least significant bit, and the start bit of a big-endian signal is its most significant
+// bit in the sawtooth bit numbering of DBC files.
+func bitPositions(start, length uint64, bigEndian bool) []uint64 {
+	positions := make([]uint64, length)
+	if !bigEndian {
+		for i := range positions {
+			positions[i] = start + uint64(i)
+		}
+		return positions
+	}
+	position := start
+	for i := int(length) - 1; i >= 0; i-- {
+		positions[i] = position
+		if position%8 == 0 {
+			position += 15
+		} else {
+			position--
+		}
+	}
+	return positions
+}
+
+func bitRangeInFrame(start, length uint64, bigEndian bool, size uint64) bool {
+	for _, position := range bitPositions(start, length, bigEndian) {
+		if position >= size*8 {
+			return false
+		}
+	}
+	return true
+}
+
+func unsignedBits(data []byte, signal *descriptor.Signal) uint64 {
+	var value uint64
+	for i, position := range bitPositions(uint64(signal.Start), uint64(signal.Length), signal.IsBigEndian) {
+		if data[position/8]&(1<<(position%8)) != 0 {
+			value |= 1 << uint(i)
+		}
+	}
+	return value
+}
+
+func signedBits(data []byte, signal *descriptor.Signal) int64 {
+	value := unsignedBits(data, signal)
+	if signal.Length < 64 && value&(1<<(signal.Length-1)) != 0 {
+		value |= math.MaxUint64 << signal.Length
+	}
+	return int64(value)
+}
+
+func setBits(data []byte, signal *descriptor.Signal, value uint64) {
+	for i, position := range bitPositions(uint64(signal.Start), uint64(signal.Length), signal.IsBigEndian) {
+		if value&(1<<uint(i)) != 0 {
+			data[position/8] |= 1 << (position % 8)
+		} else {
+			data[position/8] &^= 1 << (position % 8)
+		}
+	}
+}
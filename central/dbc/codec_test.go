@@ -0,0 +1,186 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dbc
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.einride.tech/can"
+)
+
+const canFDDBC = `VERSION ""
+
+NS_ :
+
+BS_:
+
+BU_:
+
+BO_ 2147484160 Battery: 64 Vector__XXX
+ SG_ Voltage : 480|16@1+ (0.01,0) [0|655.35] "V" Vector__XXX
+ SG_ Current : 496|16@0- (0.1,0) [-1000|1000] "A" Vector__XXX
+`
+
+// j1939DBC has the unsigned J1939 engine temperatures, whose offset of -40 makes their physical values negative
+const j1939DBC = `VERSION ""
+
+NS_ :
+
+BS_:
+
+BU_: Engine
+
+BO_ 2364540158 EngineTemperature: 8 Engine
+ SG_ CoolantTemp : 0|8@1+ (1,-40) [-40|210] "degC" Vector__XXX
+ SG_ FuelTemp : 8|8@1+ (1,-40) [-40|210] "degC" Vector__XXX
+`
+
+// codecMuxDBC multiplexes a little-endian temperature and a big-endian pressure over the bytes 1 and 2
+const codecMuxDBC = `VERSION ""
+
+NS_ :
+
+BS_:
+
+BU_: ECU Gateway
+
+BO_ 1280 Diagnostics: 8 ECU
+ SG_ Mode M : 0|8@1+ (1,0) [0|2] "" Gateway
+ SG_ Temperature m0 : 8|16@1- (0.1,-40) [-40|215] "degC" Gateway
+ SG_ Pressure m1 : 15|16@0+ (0.5,0) [0|1000] "kPa" Gateway,ECU
+
+BA_DEF_ SG_  "GenSigStartValue" INT -2147483648 2147483647;
+BA_DEF_DEF_  "GenSigStartValue" 0;
+BA_ "GenSigStartValue" SG_ 1280 Temperature 400;
+VAL_ 1280 Mode 0 "Temperature" 1 "Pressure" ;
+`
+
+func newTestCodec(t *testing.T, data string) *Codec {
+	profiles, err, validateErrors := ConvertDBCtoProfile([]byte(data))
+	require.NoError(t, err)
+	require.Empty(t, validateErrors)
+	codec, err := NewCodec(profiles[0])
+	require.NoError(t, err)
+	return codec
+}
+
+func readingValues(event dtos.Event) map[string]string {
+	values := make(map[string]string, len(event.Readings))
+	for _, reading := range event.Readings {
+		values[reading.ResourceName] = reading.Value
+	}
+	return values
+}
+
+func TestCodec_Multiplexed(t *testing.T) {
+	codec := newTestCodec(t, codecMuxDBC)
+
+	// Mode 0 selects the temperature, the raw value 650 is 25 degC
+	event, err := codec.Decode("engine", Frame{ID: 1280, Data: []byte{0x00, 0x8A, 0x02, 0, 0, 0, 0, 0}})
+	require.NoError(t, err)
+	assert.Equal(t, "Diagnostics", event.ProfileName)
+	assert.Equal(t, "engine", event.DeviceName)
+	assert.Equal(t, map[string]string{"Mode": "0", "Temperature": "2.500000e+01"}, readingValues(event))
+
+	// Mode 1 selects the big-endian pressure, the raw value 0x0123 is 145.5 kPa
+	data := can.Data{0x01, 0x01, 0x23}
+	pressure := codec.message.Signals[2]
+	require.Equal(t, "Pressure", pressure.Name)
+	assert.Equal(t, data.UnsignedBitsBigEndian(pressure.Start, pressure.Length), unsignedBits(data[:], pressure))
+	event, err = codec.Decode("engine", Frame{ID: 1280, Data: data[:]})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Mode": "1", "Pressure": "1.455000e+02"}, readingValues(event))
+
+	frame, err := codec.Encode(map[string]any{"Mode": 1, "Pressure": 145.5})
+	require.NoError(t, err)
+	assert.Equal(t, data[:], frame.Data)
+
+	// the temperature without a value is encoded with its start value 400, which is 0 degC
+	frame, err = codec.Encode(map[string]any{"Mode": 0})
+	require.NoError(t, err)
+	event, err = codec.Decode("engine", frame)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Mode": "0", "Temperature": "0.000000e+00"}, readingValues(event))
+}
+
+func TestCodec_CANFD(t *testing.T) {
+	codec := newTestCodec(t, canFDDBC)
+
+	frame, err := codec.Encode(map[string]any{"Voltage": "48.5", "Current": -12.3})
+	require.NoError(t, err)
+	require.Len(t, frame.Data, 64)
+	assert.Equal(t, uint32(512), frame.ID)
+
+	event, err := codec.Decode("battery", frame)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Voltage": "4.850000e+01", "Current": "-1.230000e+01"}, readingValues(event))
+}
+
+func TestCodec_NegativeOffset(t *testing.T) {
+	profiles, err, validateErrors := ConvertDBCtoProfile([]byte(j1939DBC))
+	require.NoError(t, err)
+	require.Empty(t, validateErrors)
+	require.Len(t, profiles, 1)
+	for _, resource := range profiles[0].DeviceResources {
+		assert.Equal(t, common.ValueTypeInt64, resource.Properties.ValueType, resource.Name)
+	}
+
+	// a profile converted while the unsigned signals were read as Uint64 is still decoded
+	legacy := profiles[0]
+	legacy.DeviceResources = slices.Clone(legacy.DeviceResources)
+	legacy.DeviceResources[1].Properties.ValueType = common.ValueTypeUint64
+	for _, profile := range []dtos.DeviceProfile{profiles[0], legacy} {
+		codec, err := NewCodec(profile)
+		require.NoError(t, err)
+
+		frame, err := codec.Encode(map[string]any{"CoolantTemp": -20, "FuelTemp": 85})
+		require.NoError(t, err)
+		assert.Equal(t, []byte{20, 125, 0, 0, 0, 0, 0, 0}, frame.Data)
+
+		event, err := codec.Decode("engine", frame)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"CoolantTemp": "-20", "FuelTemp": "85"}, readingValues(event))
+	}
+}
+
+func TestCodec_Error(t *testing.T) {
+	codec := newTestCodec(t, codecMuxDBC)
+
+	_, err := codec.Decode("engine", Frame{ID: 1281, Data: make([]byte, 8)})
+	assert.Error(t, err, "wrong frame ID")
+	_, err = codec.Decode("engine", Frame{ID: 1280, Data: make([]byte, 4)})
+	assert.Error(t, err, "short frame")
+
+	encodeTests := []struct {
+		name   string
+		values map[string]any
+	}{
+		{"unknown signal", map[string]any{"Speed": 1}},
+		{"not a number", map[string]any{"Mode": 0, "Temperature": "hot"}},
+		{"above maximum", map[string]any{"Mode": 0, "Temperature": 216}},
+		{"below minimum", map[string]any{"Mode": 0, "Temperature": -41}},
+		{"not selected by the multiplexer", map[string]any{"Mode": 0, "Pressure": 10}},
+	}
+	for _, testCase := range encodeTests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := codec.Encode(testCase.values)
+			assert.Error(t, err)
+		})
+	}
+
+	profiles, err, _ := ConvertDBCtoProfile([]byte(canFDDBC))
+	require.NoError(t, err)
+	profiles[0].DeviceResources[0].Attributes[BitStart] = 504
+	_, err = NewCodec(profiles[0])
+	assert.Error(t, err, "signal outside of the frame")
+}
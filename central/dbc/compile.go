@@ -8,6 +8,7 @@ package dbc
 import (
 	"fmt"
 	"sort"
+	"text/scanner"
 	"time"
 
 	"go.einride.tech/can/pkg/dbc"
@@ -17,6 +18,17 @@ import (
 type CompileResult struct {
 	Database *descriptor.Database
 	Warnings []error
+	// ProtocolType is the value of the network attribute "ProtocolType", e.g. J1939
+	ProtocolType string
+	// SPNs are the values of the signal attribute "SPN" by CAN ID and signal name
+	SPNs map[uint32]map[string]uint32
+	// SendTypes are the values of the message attribute "GenMsgSendType" by CAN ID, which keep the send types not
+	// known by descriptor.SendType, e.g. OnChange
+	SendTypes map[uint32]string
+	// MessagePositions are the positions of the message definitions by CAN ID
+	MessagePositions map[uint32]scanner.Position
+	// SignalPositions are the positions of the signal definitions by CAN ID and signal name
+	SignalPositions map[uint32]map[string]scanner.Position
 }
 
 func Compile(sourceFile string, data []byte) (result *CompileResult, err error) {
@@ -26,13 +38,19 @@ func Compile(sourceFile string, data []byte) (result *CompileResult, err error)
 	}
 	defs := p.Defs()
 	c := &compiler{
-		db:   &descriptor.Database{},
-		defs: defs,
+		db:        &descriptor.Database{},
+		defs:      defs,
+		spns:      make(map[uint32]map[string]uint32),
+		sendTypes: make(map[uint32]string),
+
+		messagePositions: make(map[uint32]scanner.Position),
+		signalPositions:  make(map[uint32]map[string]scanner.Position),
 	}
 	c.collectDescriptors()
 	c.addMetadata()
 	c.sortDescriptors()
-	return &CompileResult{Database: c.db, Warnings: c.warnings}, nil
+	return &CompileResult{Database: c.db, Warnings: c.warnings, ProtocolType: c.protocolType, SPNs: c.spns, SendTypes: c.sendTypes,
+		MessagePositions: c.messagePositions, SignalPositions: c.signalPositions}, nil
 }
 
 type compileError struct {
@@ -45,9 +63,15 @@ func (e *compileError) Error() string {
 }
 
 type compiler struct {
-	db       *descriptor.Database
-	defs     []dbc.Def
-	warnings []error
+	db           *descriptor.Database
+	defs         []dbc.Def
+	warnings     []error
+	protocolType string
+	spns         map[uint32]map[string]uint32
+	sendTypes    map[uint32]string
+
+	messagePositions map[uint32]scanner.Position
+	signalPositions  map[uint32]map[string]scanner.Position
 }
 
 func (c *compiler) addWarning(warning error) {
@@ -70,6 +94,8 @@ func (c *compiler) collectDescriptors() {
 				Length:     uint8(def.Size),
 				SenderNode: string(def.Transmitter),
 			}
+			c.messagePositions[message.ID] = def.Pos
+			c.signalPositions[message.ID] = make(map[string]scanner.Position, len(def.Signals))
 			for _, signalDef := range def.Signals {
 				signal := &descriptor.Signal{
 					Name:             string(signalDef.Name),
@@ -90,6 +116,7 @@ func (c *compiler) collectDescriptors() {
 					signal.ReceiverNodes = append(signal.ReceiverNodes, string(receiver))
 				}
 				message.Signals = append(message.Signals, signal)
+				c.signalPositions[message.ID][signal.Name] = signalDef.Pos
 			}
 			c.db.Messages = append(c.db.Messages, message)
 		case *dbc.NodesDef:
@@ -160,7 +187,8 @@ func (c *compiler) addMetadata() {
 					continue
 				}
 				switch def.AttributeName {
-				case "GenMsgSendType":
+				case genMsgSendType:
+					c.sendTypes[msg.ID] = def.StringValue
 					if err := msg.SendType.UnmarshalString(def.StringValue); err != nil {
 						c.addWarning(&compileError{def: def, reason: err.Error()})
 						continue
@@ -174,9 +202,20 @@ func (c *compiler) addMetadata() {
 				sig, ok := c.db.Signal(def.MessageID.ToCAN(), string(def.SignalName))
 				if !ok {
 					c.addWarning(&compileError{def: def, reason: "no declared signal"})
+					continue
 				}
-				if def.AttributeName == "GenSigStartValue" {
+				switch def.AttributeName {
+				case "GenSigStartValue":
 					sig.DefaultValue = int(def.IntValue)
+				case spnAttribute:
+					if c.spns[def.MessageID.ToCAN()] == nil {
+						c.spns[def.MessageID.ToCAN()] = make(map[string]uint32)
+					}
+					c.spns[def.MessageID.ToCAN()][sig.Name] = uint32(def.IntValue)
+				}
+			case dbc.ObjectTypeUnspecified:
+				if def.AttributeName == protocolTypeAttribute {
+					c.protocolType = def.StringValue
 				}
 			}
 		}
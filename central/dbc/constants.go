@@ -5,20 +5,27 @@
 
 package dbc
 
+import (
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
 const (
 	ServiceName = "ServiceName"
 
-	Canbus          = "CANbus"
+	Canbus          = common.Canbus
 	J1939           = "J1939"
-	Network         = "Network"
-	Standard        = "Standard"
-	ID              = "ID"
-	DataSize        = "DataSize"
+	CAN             = "CAN"
+	Network         = common.CanbusNetwork
+	Standard        = common.CanbusStandard
+	ID              = common.CanbusID
+	DataSize        = common.CanbusDataSize
 	Sender          = "Sender"
 	PGN             = "PGN"
 	CommType        = "CommType"
 	CommTypeTCP     = "TCP"
-	Port            = "Port"
+	Port            = common.CanbusPort
 	NetType         = "NetType"
 	NetTypeEthernet = "Ethernet"
 
@@ -29,8 +36,34 @@ const (
 	MuxSignal     = "muxSignal"
 	MuxNum        = "muxNum"
 	IsSigned      = "isSigned"
+	MessageID     = "messageId"
+	MessageSize   = "messageSize"
+	SenderName    = "senderName"
+	SPN           = "spn"
+
+	Priority           = "Priority"
+	SourceAddress      = "SourceAddress"
+	DestinationAddress = "DestinationAddress"
+	PDUFormat          = "PDUFormat"
+	PDU1               = "PDU1"
+	PDU2               = "PDU2"
+
+	genSigStartValue      = "GenSigStartValue"
+	genMsgSendType        = "GenMsgSendType"
+	spnAttribute          = "SPN"
+	protocolTypeAttribute = "ProtocolType"
+	vectorXXX             = "Vector__XXX"
+
+	sendTypeOnChange      = "OnChange"
+	sendTypeOnWrite       = "OnWrite"
+	sendTypeNoMsgSendType = "NoMsgSendType"
+	sendTypeNotUsed       = "NotUsed"
+	// defaultOnChangeInterval is the interval of the OnChange AutoEvent of a message without GenMsgDelayTime
+	defaultOnChangeInterval = time.Second
 
 	messageIDExtendedFlag = 0x80000000
-	j1939PGNOffset        = 8
-	j1939PGNMask          = 0x3FFFF
+	j1939PriorityOffset   = 26
+	j1939PriorityMask     = 0x7
+	j1939PDU2Threshold    = 240
+	j1939GlobalAddress    = 0xFF
 )
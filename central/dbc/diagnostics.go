@@ -0,0 +1,175 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dbc
+
+import (
+	goerrors "errors"
+	"fmt"
+	"strings"
+	"text/scanner"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"go.einride.tech/can/pkg/dbc"
+	"go.einride.tech/can/pkg/descriptor"
+)
+
+// Severity is the severity of a Diagnostic
+type Severity string
+
+const (
+	// SeverityError means the object is not converted
+	SeverityError Severity = "Error"
+	// SeverityWarning means the object is converted, but some of its definitions are ignored
+	SeverityWarning Severity = "Warning"
+)
+
+// Diagnostic is a problem found in a DBC file. Line and Column are the position of the definition in the DBC file, and
+// are zero if the position is unknown. Message, MessageID, Signal and Node identify the object of the definition, the
+// Message is empty if the message is not declared.
+type Diagnostic struct {
+	Severity  Severity `json:"severity"`
+	Line      int      `json:"line,omitempty"`
+	Column    int      `json:"column,omitempty"`
+	Message   string   `json:"message,omitempty"`
+	MessageID uint32   `json:"messageId,omitempty"`
+	Signal    string   `json:"signal,omitempty"`
+	Node      string   `json:"node,omitempty"`
+	Reason    string   `json:"reason"`
+}
+
+func (d Diagnostic) String() string {
+	var object []string
+	if d.Message != "" {
+		object = append(object, fmt.Sprintf("message %s", d.Message))
+	} else if d.MessageID != 0 {
+		object = append(object, fmt.Sprintf("message %d", d.MessageID))
+	}
+	if d.Signal != "" {
+		object = append(object, fmt.Sprintf("signal %s", d.Signal))
+	}
+	if d.Node != "" {
+		object = append(object, fmt.Sprintf("node %s", d.Node))
+	}
+	return fmt.Sprintf("%s %d:%d %s: %s", d.Severity, d.Line, d.Column, strings.Join(object, " "), d.Reason)
+}
+
+// Diagnostics is the report of the problems found while converting a DBC file
+type Diagnostics []Diagnostic
+
+// HasErrors returns true if any object is not converted
+func (d Diagnostics) HasErrors() bool {
+	for _, diagnostic := range d {
+		if diagnostic.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Errors returns the diagnostics with SeverityError
+func (d Diagnostics) Errors() Diagnostics {
+	return d.filter(SeverityError)
+}
+
+// Warnings returns the diagnostics with SeverityWarning
+func (d Diagnostics) Warnings() Diagnostics {
+	return d.filter(SeverityWarning)
+}
+
+func (d Diagnostics) filter(severity Severity) Diagnostics {
+	var result Diagnostics
+	for _, diagnostic := range d {
+		if diagnostic.Severity == severity {
+			result = append(result, diagnostic)
+		}
+	}
+	return result
+}
+
+// validateErrors returns the reasons of the diagnostics, which is the validateErrors returned by ConvertDBCtoProfile
+// and ConvertDBCtoDevice. The reasons of a message, e.g. its unsupported send type, are keyed by the message name, and
+// the reasons of a signal, e.g. a signal left out of its profile, by the message and signal name, e.g. Status.State.
+// Both the errors and the warnings are reported, and the diagnostics of the messages which are not declared are left
+// out.
+func (d Diagnostics) validateErrors() map[string]error {
+	reasons := make(map[string][]string)
+	var keys []string
+	for _, diagnostic := range d {
+		if diagnostic.Message == "" {
+			continue
+		}
+		key := diagnostic.Message
+		if diagnostic.Signal != "" {
+			key = diagnostic.Message + "." + diagnostic.Signal
+		}
+		if _, ok := reasons[key]; !ok {
+			keys = append(keys, key)
+		}
+		reasons[key] = append(reasons[key], fmt.Sprintf("%s: %s", strings.ToLower(string(diagnostic.Severity)), diagnostic.Reason))
+	}
+	validateErrors := make(map[string]error, len(keys))
+	for _, key := range keys {
+		validateErrors[key] = errors.NewCommonEdgeX(errors.KindContractInvalid, strings.Join(reasons[key], "; "), nil)
+	}
+	return validateErrors
+}
+
+func newDiagnostic(severity Severity, pos scanner.Position, m *descriptor.Message, signal string, reason string) Diagnostic {
+	return Diagnostic{
+		Severity:  severity,
+		Line:      pos.Line,
+		Column:    pos.Column,
+		Message:   m.Name,
+		MessageID: dbcMessageID(m),
+		Signal:    signal,
+		Reason:    reason,
+	}
+}
+
+// parseDiagnostic returns the diagnostic of the error failing to compile the DBC file
+func parseDiagnostic(err error) Diagnostic {
+	var dbcErr dbc.Error
+	if goerrors.As(err, &dbcErr) {
+		return Diagnostic{Severity: SeverityError, Line: dbcErr.Position().Line, Column: dbcErr.Position().Column, Reason: dbcErr.Reason()}
+	}
+	return Diagnostic{Severity: SeverityError, Reason: err.Error()}
+}
+
+// compileDiagnostics returns the warnings of the compile result as diagnostics
+func compileDiagnostics(result *CompileResult) Diagnostics {
+	var diagnostics Diagnostics
+	for _, warning := range result.Warnings {
+		compileErr, ok := warning.(*compileError)
+		if !ok {
+			diagnostics = append(diagnostics, Diagnostic{Severity: SeverityWarning, Reason: warning.Error()})
+			continue
+		}
+		diagnostic := Diagnostic{
+			Severity: SeverityWarning,
+			Line:     compileErr.def.Position().Line,
+			Column:   compileErr.def.Position().Column,
+			Reason:   compileErr.reason,
+		}
+		var messageID dbc.MessageID
+		switch def := compileErr.def.(type) {
+		case *dbc.CommentDef:
+			messageID, diagnostic.Signal, diagnostic.Node = def.MessageID, string(def.SignalName), string(def.NodeName)
+		case *dbc.ValueDescriptionsDef:
+			messageID, diagnostic.Signal = def.MessageID, string(def.SignalName)
+		case *dbc.AttributeValueForObjectDef:
+			messageID, diagnostic.Signal, diagnostic.Node = def.MessageID, string(def.SignalName), string(def.NodeName)
+		}
+		if messageID != 0 {
+			diagnostic.MessageID = uint32(messageID)
+			if m, ok := result.Database.Message(messageID.ToCAN()); ok {
+				diagnostic.Message = m.Name
+			}
+		}
+		diagnostics = append(diagnostics, diagnostic)
+	}
+	return diagnostics
+}
@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dbc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const diagnosticsDBC = `VERSION ""
+
+BS_:
+
+BU_: ECU
+
+BO_ 256 Status: 2 ECU
+ SG_ State : 0|8@1+ (1,0) [0|255] "" Vector__XXX
+ SG_ State : 8|8@1+ (1,0) [0|255] "" Vector__XXX
+
+BO_ 257 Cyclic: 1 ECU
+ SG_ Counter : 0|8@1+ (1,0) [0|255] "" Vector__XXX
+
+CM_ BO_ 512 "Orphan message comment";
+CM_ SG_ 256 Mode "Orphan signal comment";
+BA_DEF_ BO_ "GenMsgSendType" STRING ;
+BA_ "GenMsgSendType" BO_ 257 "Spontaneous";
+`
+
+func TestConvertDBCtoProfileWithDiagnostics(t *testing.T) {
+	profiles, diagnostics, err := ConvertDBCtoProfileWithDiagnostics([]byte(diagnosticsDBC))
+	require.NoError(t, err)
+	require.Len(t, profiles, 2, "a bad signal doesn't drop its message")
+	require.Len(t, profiles[0].DeviceResources, 1)
+	assert.Equal(t, "State", profiles[0].DeviceResources[0].Name)
+
+	assert.Equal(t, Diagnostics{
+		{Severity: SeverityWarning, Line: 14, Column: 1, MessageID: 512, Reason: "no declared message"},
+		{Severity: SeverityWarning, Line: 15, Column: 1, Message: "Status", MessageID: 256, Signal: "Mode", Reason: "no declared signal"},
+		{Severity: SeverityError, Line: 9, Column: 2, Message: "Status", MessageID: 256, Signal: "State", Reason: "device resource State is duplicated"},
+	}, diagnostics)
+	assert.True(t, diagnostics.HasErrors())
+	assert.Len(t, diagnostics.Errors(), 1)
+	assert.Len(t, diagnostics.Warnings(), 2)
+
+	_, _, validateErrors := ConvertDBCtoProfile([]byte(diagnosticsDBC))
+	require.Len(t, validateErrors, 2, "the signals left out of a converted message are reported by message and signal")
+	assert.Equal(t, "warning: no declared signal", validateErrors["Status.Mode"].Error())
+	assert.Equal(t, "error: device resource State is duplicated", validateErrors["Status.State"].Error())
+}
+
+func TestConvertDBCtoDeviceWithDiagnostics(t *testing.T) {
+	devices, diagnostics, err := ConvertDBCtoDeviceWithDiagnostics([]byte(diagnosticsDBC), map[string]string{ServiceName: "device-can"})
+	require.NoError(t, err)
+	require.Len(t, devices, 2)
+	require.Len(t, diagnostics, 3)
+	assert.Equal(t, Diagnostic{Severity: SeverityWarning, Line: 11, Column: 1, Message: "Cyclic", MessageID: 257,
+		Reason: "send type Spontaneous of message Cyclic is not supported, the device has no AutoEvent"}, diagnostics[2])
+	assert.False(t, diagnostics.HasErrors())
+
+	_, _, validateErrors := ConvertDBCtoDevice([]byte(diagnosticsDBC), map[string]string{ServiceName: "device-can"})
+	require.Len(t, validateErrors, 2)
+	assert.Contains(t, validateErrors["Cyclic"].Error(), "warning: send type Spontaneous", "the unsupported send type is reported as a warning")
+	assert.Equal(t, "warning: no declared signal", validateErrors["Status.Mode"].Error())
+
+	// the devices without service name fail the validation, so they are left out
+	devices, diagnostics, err = ConvertDBCtoDeviceWithDiagnostics([]byte(diagnosticsDBC), nil)
+	require.NoError(t, err)
+	assert.Empty(t, devices)
+	assert.Len(t, diagnostics.Errors(), 2)
+	_, _, validateErrors = ConvertDBCtoDevice([]byte(diagnosticsDBC), nil)
+	require.Len(t, validateErrors, 3)
+	assert.Contains(t, validateErrors["Status"].Error(), "error: ")
+	assert.Contains(t, validateErrors["Cyclic"].Error(), "error: ")
+	assert.Contains(t, validateErrors, "Status.Mode")
+}
+
+func TestConvertDBCWithDiagnostics_ParseError(t *testing.T) {
+	_, diagnostics, err := ConvertDBCtoProfileWithDiagnostics([]byte("VERSION \"\"\n\nBO_ Status: 1 ECU\n"))
+	require.Error(t, err)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, SeverityError, diagnostics[0].Severity)
+	assert.Equal(t, 3, diagnostics[0].Line)
+	assert.NotEmpty(t, diagnostics[0].Reason)
+}
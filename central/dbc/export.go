@@ -0,0 +1,360 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dbc
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// maxSPN is the largest J1939 suspect parameter number, which has 19 bits
+const maxSPN = 524287
+
+// newSymbols are the new symbols written to the NS_ section of the generated DBC file
+var newSymbols = []string{
+	"NS_DESC_", "CM_", "BA_DEF_", "BA_", "VAL_", "CAT_DEF_", "CAT_", "FILTER", "BA_DEF_DEF_", "EV_DATA_", "ENVVAR_DATA_",
+	"SGTYPE_", "SGTYPE_VAL_", "BA_DEF_SGTYPE_", "BA_SGTYPE_", "SIG_TYPE_REF_", "VAL_TABLE_", "SIG_GROUP_", "SIG_VALTYPE_",
+	"SIGTYPE_VALTYPE_", "BO_TX_BU_", "BA_DEF_REL_", "BA_REL_", "BA_DEF_DEF_REL_", "BU_SG_REL_", "BU_EV_REL_", "BU_BO_REL_",
+	"SG_MUL_VAL_",
+}
+
+type dbcSignal struct {
+	name              string
+	description       string
+	start             uint64
+	length            uint64
+	littleEndian      bool
+	signed            bool
+	multiplexer       bool
+	multiplexed       bool
+	multiplexerValue  uint64
+	scale             float64
+	offset            float64
+	minimum           float64
+	maximum           float64
+	unit              string
+	receivers         []string
+	defaultValue      int64
+	spn               *uint64
+	valueDescriptions map[int64]string
+}
+
+type dbcMessage struct {
+	id          uint32
+	name        string
+	description string
+	size        uint64
+	sender      string
+	signals     []dbcSignal
+}
+
+// ConvertProfileToDBC generates a DBC file from the device profiles compiled by ConvertDBCtoProfile, where each
+// profile is a CAN message and each DeviceResource a signal of the message. The message ID, size and sender are read
+// from the resource attributes, and the value descriptions from the ResourceOperation mappings of the DeviceCommands.
+func ConvertProfileToDBC(profiles []dtos.DeviceProfile) ([]byte, error) {
+	messages := make([]dbcMessage, 0, len(profiles))
+	for _, profile := range profiles {
+		message, err := toDBCMessage(profile)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to convert profile %s to a DBC message", profile.Name), err)
+		}
+		messages = append(messages, message)
+	}
+	slices.SortStableFunc(messages, func(a, b dbcMessage) int {
+		return int(int64(a.id&^messageIDExtendedFlag) - int64(b.id&^messageIDExtendedFlag))
+	})
+
+	var nodes []string
+	for _, m := range messages {
+		nodes = appendNode(nodes, m.sender)
+		for _, s := range m.signals {
+			for _, receiver := range s.receivers {
+				nodes = appendNode(nodes, receiver)
+			}
+		}
+	}
+	slices.Sort(nodes)
+
+	var b strings.Builder
+	b.WriteString("VERSION \"\"\n\n\nNS_ : \n")
+	for _, symbol := range newSymbols {
+		fmt.Fprintf(&b, "\t%s\n", symbol)
+	}
+	b.WriteString("\nBS_:\n\n")
+	fmt.Fprintf(&b, "BU_: %s\n\n", strings.Join(nodes, " "))
+
+	for _, m := range messages {
+		fmt.Fprintf(&b, "BO_ %d %s: %d %s\n", m.id, m.name, m.size, m.sender)
+		for _, s := range m.signals {
+			b.WriteString(s.definition())
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	for _, m := range messages {
+		if m.description != "" {
+			fmt.Fprintf(&b, "CM_ BO_ %d %s;\n", m.id, quote(m.description))
+		}
+		for _, s := range m.signals {
+			if s.description != "" {
+				fmt.Fprintf(&b, "CM_ SG_ %d %s %s;\n", m.id, s.name, quote(s.description))
+			}
+		}
+	}
+
+	var startValues strings.Builder
+	for _, m := range messages {
+		for _, s := range m.signals {
+			if s.defaultValue != 0 {
+				fmt.Fprintf(&startValues, "BA_ \"%s\" SG_ %d %s %d;\n", genSigStartValue, m.id, s.name, s.defaultValue)
+			}
+		}
+	}
+	if startValues.Len() > 0 {
+		fmt.Fprintf(&b, "\nBA_DEF_ SG_  \"%s\" INT %d %d;\n", genSigStartValue, math.MinInt32, math.MaxInt32)
+		fmt.Fprintf(&b, "BA_DEF_DEF_  \"%s\" 0;\n", genSigStartValue)
+		b.WriteString(startValues.String())
+	}
+
+	var spns strings.Builder
+	for _, m := range messages {
+		for _, s := range m.signals {
+			if s.spn != nil {
+				fmt.Fprintf(&spns, "BA_ \"%s\" SG_ %d %s %d;\n", spnAttribute, m.id, s.name, *s.spn)
+			}
+		}
+	}
+	if spns.Len() > 0 {
+		fmt.Fprintf(&b, "\nBA_DEF_ SG_  \"%s\" INT 0 %d;\n", spnAttribute, maxSPN)
+		fmt.Fprintf(&b, "BA_DEF_DEF_  \"%s\" 0;\n", spnAttribute)
+		b.WriteString(spns.String())
+	}
+
+	b.WriteString("\n")
+	for _, m := range messages {
+		for _, s := range m.signals {
+			if len(s.valueDescriptions) == 0 {
+				continue
+			}
+			values := make([]int64, 0, len(s.valueDescriptions))
+			for value := range s.valueDescriptions {
+				values = append(values, value)
+			}
+			slices.Sort(values)
+			fmt.Fprintf(&b, "VAL_ %d %s", m.id, s.name)
+			for _, value := range values {
+				fmt.Fprintf(&b, " %d %s", value, quote(s.valueDescriptions[value]))
+			}
+			b.WriteString(" ;\n")
+		}
+	}
+
+	data := []byte(b.String())
+	if _, err := Compile("", data); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "the generated DBC file is invalid", err)
+	}
+	return data, nil
+}
+
+func toDBCMessage(profile dtos.DeviceProfile) (dbcMessage, errors.EdgeX) {
+	message := dbcMessage{name: profile.Name, description: profile.Description, sender: vectorXXX}
+	if len(profile.DeviceResources) == 0 {
+		return message, errors.NewCommonEdgeX(errors.KindContractInvalid, "the profile has no DeviceResource", nil)
+	}
+
+	valueDescriptions := make(map[string]map[int64]string)
+	for _, command := range profile.DeviceCommands {
+		for _, ro := range command.ResourceOperations {
+			for raw, description := range ro.Mappings {
+				value, err := strconv.ParseInt(raw, 10, 64)
+				if err != nil {
+					return message, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("mapping '%s' of resource %s is not an integer", raw, ro.DeviceResource), err)
+				}
+				if valueDescriptions[ro.DeviceResource] == nil {
+					valueDescriptions[ro.DeviceResource] = make(map[int64]string)
+				}
+				valueDescriptions[ro.DeviceResource][value] = description
+			}
+		}
+	}
+
+	for i, resource := range profile.DeviceResources {
+		id, err := uintAttribute(resource, MessageID, 32)
+		if err != nil {
+			return message, errors.NewCommonEdgeXWrapper(err)
+		}
+		size, err := uintAttribute(resource, MessageSize, 8)
+		if err != nil {
+			return message, errors.NewCommonEdgeXWrapper(err)
+		}
+		if i == 0 {
+			message.id, message.size = uint32(id), size
+			if sender, ok := resource.Attributes[SenderName]; ok && fmt.Sprintf("%v", sender) != "" {
+				message.sender = fmt.Sprintf("%v", sender)
+			}
+		} else if uint32(id) != message.id || size != message.size {
+			return message, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("resource %s belongs to another message than resource %s", resource.Name, profile.DeviceResources[0].Name), nil)
+		}
+
+		signal, err := toDBCSignal(resource)
+		if err != nil {
+			return message, errors.NewCommonEdgeXWrapper(err)
+		}
+		signal.valueDescriptions = valueDescriptions[resource.Name]
+		message.signals = append(message.signals, signal)
+	}
+	return message, nil
+}
+
+func toDBCSignal(resource dtos.DeviceResource) (dbcSignal, errors.EdgeX) {
+	signal := dbcSignal{name: resource.Name, description: resource.Description, scale: 1, unit: resource.Properties.Units}
+	var err errors.EdgeX
+	if signal.start, err = uintAttribute(resource, BitStart, 8); err != nil {
+		return signal, errors.NewCommonEdgeXWrapper(err)
+	}
+	if signal.length, err = uintAttribute(resource, BitLen, 8); err != nil {
+		return signal, errors.NewCommonEdgeXWrapper(err)
+	}
+	if signal.littleEndian, err = boolAttribute(resource, LittleEndian); err != nil {
+		return signal, errors.NewCommonEdgeXWrapper(err)
+	}
+	if signal.signed, err = boolAttribute(resource, IsSigned); err != nil {
+		return signal, errors.NewCommonEdgeXWrapper(err)
+	}
+	if signal.multiplexer, err = boolAttribute(resource, MuxSignal); err != nil {
+		return signal, errors.NewCommonEdgeXWrapper(err)
+	}
+	if _, ok := resource.Attributes[MuxNum]; ok {
+		signal.multiplexed = true
+		if signal.multiplexerValue, err = uintAttribute(resource, MuxNum, 64); err != nil {
+			return signal, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	if _, ok := resource.Attributes[SPN]; ok {
+		spn, err := uintAttribute(resource, SPN, 32)
+		if err != nil {
+			return signal, errors.NewCommonEdgeXWrapper(err)
+		}
+		signal.spn = &spn
+	}
+
+	if resource.Properties.Scale != nil {
+		signal.scale = *resource.Properties.Scale
+	}
+	if resource.Properties.Offset != nil {
+		signal.offset = *resource.Properties.Offset
+	}
+	if resource.Properties.Minimum != nil {
+		signal.minimum = *resource.Properties.Minimum
+	}
+	if resource.Properties.Maximum != nil {
+		signal.maximum = *resource.Properties.Maximum
+	}
+	if resource.Properties.DefaultValue != "" {
+		defaultValue, parseErr := strconv.ParseFloat(resource.Properties.DefaultValue, 64)
+		if parseErr != nil {
+			return signal, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("default value '%s' of resource %s is not a number", resource.Properties.DefaultValue, resource.Name), parseErr)
+		}
+		signal.defaultValue = int64(defaultValue)
+	}
+
+	switch receivers := resource.Attributes[ReceiverNames].(type) {
+	case []string:
+		signal.receivers = receivers
+	case []any:
+		for _, receiver := range receivers {
+			signal.receivers = append(signal.receivers, fmt.Sprintf("%v", receiver))
+		}
+	}
+	if len(signal.receivers) == 0 {
+		signal.receivers = []string{vectorXXX}
+	}
+	return signal, nil
+}
+
+// definition returns the SG_ line of the signal
+func (s dbcSignal) definition() string {
+	multiplexing := ""
+	switch {
+	case s.multiplexer:
+		multiplexing = "M "
+	case s.multiplexed:
+		multiplexing = fmt.Sprintf("m%d ", s.multiplexerValue)
+	}
+	byteOrder := "0"
+	if s.littleEndian {
+		byteOrder = "1"
+	}
+	sign := "+"
+	if s.signed {
+		sign = "-"
+	}
+	return fmt.Sprintf(" SG_ %s %s: %d|%d@%s%s (%s,%s) [%s|%s] %s %s\n", s.name, multiplexing, s.start, s.length, byteOrder, sign,
+		formatFloat(s.scale), formatFloat(s.offset), formatFloat(s.minimum), formatFloat(s.maximum), quote(s.unit), strings.Join(s.receivers, ","))
+}
+
+func uintAttribute(resource dtos.DeviceResource, name string, bitSize int) (uint64, errors.EdgeX) {
+	value, ok := resource.Attributes[name]
+	if !ok {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("attribute %s of resource %s is required", name, resource.Name), nil)
+	}
+	str := fmt.Sprintf("%v", value)
+	// attributes decoded from JSON are float64
+	if f, isFloat := value.(float64); isFloat {
+		str = strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	result, err := strconv.ParseUint(str, 10, bitSize)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("attribute %s of resource %s is not an unsigned integer", name, resource.Name), err)
+	}
+	return result, nil
+}
+
+func boolAttribute(resource dtos.DeviceResource, name string) (bool, errors.EdgeX) {
+	value, ok := resource.Attributes[name]
+	if !ok {
+		return false, nil
+	}
+	result, err := strconv.ParseBool(fmt.Sprintf("%v", value))
+	if err != nil {
+		return false, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("attribute %s of resource %s is not a boolean", name, resource.Name), err)
+	}
+	return result, nil
+}
+
+func appendNode(nodes []string, node string) []string {
+	if node == "" || node == vectorXXX || slices.Contains(nodes, node) {
+		return nodes
+	}
+	return append(nodes, node)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// quote returns the DBC string of s, the quotes which are not escaped yet are escaped as the DBC parser keeps the
+// escaped quotes of a string as they are
+func quote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' && (i == 0 || s[i-1] != '\\') {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	b.WriteByte('"')
+	return b.String()
+}
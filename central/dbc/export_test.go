@@ -0,0 +1,103 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dbc
+
+import (
+	"os"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const muxDBC = `VERSION ""
+
+NS_ :
+
+BS_:
+
+BU_: ECU Gateway
+
+BO_ 1280 Diagnostics: 8 ECU
+ SG_ Mode M : 0|8@1+ (1,0) [0|2] "" Gateway
+ SG_ Temperature m0 : 8|16@1- (0.1,-40) [-40|215] "degC" Gateway
+ SG_ Pressure m1 : 8|16@0+ (0.5,0) [0|1000] "kPa" Gateway,ECU
+
+CM_ BO_ 1280 "Diagnostic frame with a \"multiplexed\" payload";
+CM_ SG_ 1280 Pressure "Pressure of the intake";
+BA_DEF_ SG_  "GenSigStartValue" INT -2147483648 2147483647;
+BA_DEF_DEF_  "GenSigStartValue" 0;
+BA_ "GenSigStartValue" SG_ 1280 Temperature 400;
+VAL_ 1280 Mode 0 "Temperature" 1 "Pressure" ;
+`
+
+func TestConvertProfileToDBC_RoundTrip(t *testing.T) {
+	sample, err := os.ReadFile("dbc_sample.dbc")
+	require.NoError(t, err)
+
+	for name, data := range map[string][]byte{"sample": sample, "multiplexed": []byte(muxDBC)} {
+		t.Run(name, func(t *testing.T) {
+			profiles, err, validateErrors := ConvertDBCtoProfile(data)
+			require.NoError(t, err)
+			require.Empty(t, validateErrors)
+
+			exported, err := ConvertProfileToDBC(profiles)
+			require.NoError(t, err)
+
+			expected, err := Compile("", data)
+			require.NoError(t, err)
+			actual, err := Compile("", exported)
+			require.NoError(t, err)
+			assert.Equal(t, expected.Database.Messages, actual.Database.Messages)
+
+			roundTripProfiles, err, _ := ConvertDBCtoProfile(exported)
+			require.NoError(t, err)
+			assert.Equal(t, profiles, roundTripProfiles)
+		})
+	}
+}
+
+func TestConvertProfileToDBC_Error(t *testing.T) {
+	profiles, err, _ := ConvertDBCtoProfile([]byte(muxDBC))
+	require.NoError(t, err)
+
+	withAttribute := func(name string, value any) []dtos.DeviceProfile {
+		profile := profiles[0]
+		profile.DeviceResources = append([]dtos.DeviceResource(nil), profile.DeviceResources...)
+		resource := profile.DeviceResources[1]
+		resource.Attributes = make(map[string]any)
+		for k, v := range profile.DeviceResources[1].Attributes {
+			resource.Attributes[k] = v
+		}
+		if value == nil {
+			delete(resource.Attributes, name)
+		} else {
+			resource.Attributes[name] = value
+		}
+		profile.DeviceResources[1] = resource
+		return []dtos.DeviceProfile{profile}
+	}
+
+	tests := []struct {
+		name     string
+		profiles []dtos.DeviceProfile
+	}{
+		{"no resource", []dtos.DeviceProfile{{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "empty"}}}},
+		{"missing message id", withAttribute(MessageID, nil)},
+		{"another message", withAttribute(MessageID, 1281)},
+		{"invalid bit start", withAttribute(BitStart, "first")},
+		{"bit length overflow", withAttribute(BitLen, 256)},
+		{"invalid byte order", withAttribute(LittleEndian, "yes")},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := ConvertProfileToDBC(testCase.profiles)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dbc
+
+import "fmt"
+
+// J1939ID is the decomposition of the 29-bit CAN ID of a J1939 frame
+type J1939ID struct {
+	Priority      uint8
+	DataPage      uint8 // the extended data page and data page bits
+	PDUFormat     uint8
+	PDUSpecific   uint8
+	SourceAddress uint8
+}
+
+// ParseJ1939ID decomposes the CAN ID, the extended flag of a DBC message ID is ignored
+func ParseJ1939ID(canID uint32) J1939ID {
+	canID &^= messageIDExtendedFlag
+	return J1939ID{
+		Priority:      uint8((canID >> j1939PriorityOffset) & j1939PriorityMask),
+		DataPage:      uint8((canID >> 24) & 0x3),
+		PDUFormat:     uint8(canID >> 16),
+		PDUSpecific:   uint8(canID >> 8),
+		SourceAddress: uint8(canID),
+	}
+}
+
+// IsPDU1 returns whether the frame is addressed to a destination, i.e. the PDU specific field is the destination
+// address rather than the group extension of the PGN
+func (id J1939ID) IsPDU1() bool {
+	return id.PDUFormat < j1939PDU2Threshold
+}
+
+// PGN returns the parameter group number, which does not include the destination address of a PDU1 frame
+func (id J1939ID) PGN() uint32 {
+	pgn := uint32(id.DataPage)<<16 | uint32(id.PDUFormat)<<8
+	if !id.IsPDU1() {
+		pgn |= uint32(id.PDUSpecific)
+	}
+	return pgn
+}
+
+// DestinationAddress returns the destination address of a PDU1 frame, and the global address of a PDU2 frame
+func (id J1939ID) DestinationAddress() uint8 {
+	if id.IsPDU1() {
+		return id.PDUSpecific
+	}
+	return j1939GlobalAddress
+}
+
+// CANID composes the 29-bit CAN ID
+func (id J1939ID) CANID() uint32 {
+	return uint32(id.Priority&j1939PriorityMask)<<j1939PriorityOffset | uint32(id.DataPage&0x3)<<24 |
+		uint32(id.PDUFormat)<<16 | uint32(id.PDUSpecific)<<8 | uint32(id.SourceAddress)
+}
+
+// tags returns the J1939 identification of the frame as device tags
+func (id J1939ID) tags() map[string]any {
+	pduFormat := PDU2
+	if id.IsPDU1() {
+		pduFormat = PDU1
+	}
+	return map[string]any{
+		PGN:                fmt.Sprintf("%X", id.PGN()),
+		Priority:           fmt.Sprintf("%d", id.Priority),
+		SourceAddress:      fmt.Sprintf("%X", id.SourceAddress),
+		DestinationAddress: fmt.Sprintf("%X", id.DestinationAddress()),
+		PDUFormat:          pduFormat,
+	}
+}
@@ -0,0 +1,77 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dbc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJ1939ID(t *testing.T) {
+	tests := []struct {
+		name        string
+		canID       uint32
+		expected    J1939ID
+		pdu1        bool
+		pgn         uint32
+		destination uint8
+	}{
+		{"PDU2 with extended flag", 0x8CF003FE, J1939ID{Priority: 3, PDUFormat: 0xF0, PDUSpecific: 0x03, SourceAddress: 0xFE}, false, 0xF003, 0xFF},
+		{"PDU1 to destination", 0x18EA0017, J1939ID{Priority: 6, PDUFormat: 0xEA, PDUSpecific: 0x00, SourceAddress: 0x17}, true, 0xEA00, 0x00},
+		{"data page", 0x19FEF100, J1939ID{Priority: 6, DataPage: 1, PDUFormat: 0xFE, PDUSpecific: 0xF1}, false, 0x1FEF1, 0xFF},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			id := ParseJ1939ID(testCase.canID)
+			assert.Equal(t, testCase.expected, id)
+			assert.Equal(t, testCase.pdu1, id.IsPDU1())
+			assert.Equal(t, testCase.pgn, id.PGN())
+			assert.Equal(t, testCase.destination, id.DestinationAddress())
+			assert.Equal(t, testCase.canID&^messageIDExtendedFlag, id.CANID())
+		})
+	}
+}
+
+func TestConvertDBCtoDevice_Standard(t *testing.T) {
+	messages := `
+BO_ 291 Status: 8 Vector__XXX
+ SG_ State : 0|8@1+ (1,0) [0|255] "" Vector__XXX
+
+BO_ 2565472279 Request: 3 Vector__XXX
+ SG_ Requested_PGN : 0|24@1+ (1,0) [0|16777215] "" Vector__XXX
+`
+	tests := []struct {
+		name            string
+		attributes      string
+		requestStandard string
+	}{
+		{"no protocol type", "", J1939},
+		{"J1939 protocol type", "BA_DEF_  \"ProtocolType\" STRING ;\nBA_ \"ProtocolType\" \"J1939\";\n", J1939},
+		{"other protocol type", "BA_DEF_  \"ProtocolType\" STRING ;\nBA_ \"ProtocolType\" \"StandardCAN\";\n", CAN},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			devices, err, validateErrors := ConvertDBCtoDevice([]byte("VERSION \"\"\n\nBS_:\n\nBU_:\n"+messages+"\n"+testCase.attributes), map[string]string{ServiceName: "device-can"})
+			require.NoError(t, err)
+			require.Empty(t, validateErrors)
+			require.Len(t, devices, 2)
+
+			assert.Equal(t, CAN, devices[0].Protocols[Canbus][Standard], "11-bit frames are CAN frames")
+			assert.Equal(t, "291", devices[0].Protocols[Canbus][ID])
+			assert.Nil(t, devices[0].Tags)
+
+			assert.Equal(t, testCase.requestStandard, devices[1].Protocols[Canbus][Standard])
+			assert.Equal(t, "2565472279", devices[1].Protocols[Canbus][ID])
+			if testCase.requestStandard == J1939 {
+				assert.Equal(t, map[string]any{PGN: "EA00", Priority: "6", SourceAddress: "17", DestinationAddress: "0", PDUFormat: PDU1}, devices[1].Tags)
+			} else {
+				assert.Nil(t, devices[1].Tags)
+			}
+		})
+	}
+}
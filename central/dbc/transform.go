@@ -8,10 +8,13 @@ package dbc
 import (
 	"fmt"
 	"math"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
 	"go.einride.tech/can/pkg/descriptor"
@@ -23,21 +26,40 @@ func valueType(s *descriptor.Signal) string {
 	if offsetFrac != 0 || scaleFrac != 0 {
 		return common.ValueTypeFloat64
 	}
-	if s.IsSigned {
+	if s.IsSigned || mayBeNegative(s) {
 		return common.ValueTypeInt64
 	} else {
 		return common.ValueTypeUint64
 	}
 }
 
+// mayBeNegative checks if the physical value of the signal may be negative although its raw value is unsigned, e.g.
+// the J1939 temperatures with an offset of -40
+func mayBeNegative(s *descriptor.Signal) bool {
+	return s.Offset < 0 || s.Scale < 0 || s.Min < 0
+}
+
+// ConvertDBCtoProfile converts each message of the DBC data into a device profile. The validateErrors are the
+// errors and warnings of ConvertDBCtoProfileWithDiagnostics by message name, or by message.signal name for a signal.
 func ConvertDBCtoProfile(data []byte) (profileDTOs []dtos.DeviceProfile, err error, validateErrors map[string]error) {
-	compileResult, err := Compile("", data)
+	profileDTOs, diagnostics, err := ConvertDBCtoProfileWithDiagnostics(data)
 	if err != nil {
-		return
+		return nil, err, nil
 	}
+	return profileDTOs, nil, diagnostics.validateErrors()
+}
 
-	validateErrors = make(map[string]error, len(compileResult.Database.Messages))
+// ConvertDBCtoProfileWithDiagnostics converts each message of the DBC data into a device profile, and reports the
+// problems found in the DBC data. A signal failing the validation is left out of its profile, and a message is only
+// left out if its profile fails the validation.
+func ConvertDBCtoProfileWithDiagnostics(data []byte) ([]dtos.DeviceProfile, Diagnostics, error) {
+	compileResult, err := Compile("", data)
+	if err != nil {
+		return nil, Diagnostics{parseDiagnostic(err)}, err
+	}
 
+	var profileDTOs []dtos.DeviceProfile
+	diagnostics := compileDiagnostics(compileResult)
 	for _, m := range compileResult.Database.Messages {
 		var deviceResources []dtos.DeviceResource
 		var deviceCommands []dtos.DeviceCommand
@@ -63,29 +85,44 @@ func ConvertDBCtoProfile(data []byte) (profileDTOs []dtos.DeviceProfile, err err
 					ReceiverNames: s.ReceiverNodes,
 					MuxSignal:     s.IsMultiplexer,
 					IsSigned:      s.IsSigned,
+					MessageID:     dbcMessageID(m),
+					MessageSize:   m.Length,
+					SenderName:    m.SenderNode,
 				},
 			}
 			if s.IsMultiplexed {
 				deviceResource.Attributes[MuxNum] = s.MultiplexerValue
 			}
+			if spn, ok := compileResult.SPNs[m.ID][s.Name]; ok {
+				deviceResource.Attributes[SPN] = spn
+			}
+			var deviceCommand *dtos.DeviceCommand
 			if len(s.ValueDescriptions) > 0 {
-				var deviceCommand dtos.DeviceCommand
-				deviceCommand.Name = s.Name
-				deviceCommand.ReadWrite = common.ReadWrite_R
 				mappings := make(map[string]string, len(s.ValueDescriptions))
 				for _, valueDescription := range s.ValueDescriptions {
 					mappings[strconv.FormatInt(valueDescription.Value, 10)] = valueDescription.Description
 				}
-				deviceCommand.ResourceOperations = []dtos.ResourceOperation{
-					{
-						DeviceResource: s.Name,
-						DefaultValue:   strconv.FormatInt(int64(s.DefaultValue), 10),
-						Mappings:       mappings,
+				deviceCommand = &dtos.DeviceCommand{
+					Name:      s.Name,
+					ReadWrite: common.ReadWrite_R,
+					ResourceOperations: []dtos.ResourceOperation{
+						{
+							DeviceResource: s.Name,
+							DefaultValue:   strconv.FormatInt(int64(s.DefaultValue), 10),
+							Mappings:       mappings,
+						},
 					},
 				}
-				deviceCommands = append(deviceCommands, deviceCommand)
+			}
+
+			if validateErr := validateSignal(deviceResources, deviceResource, deviceCommand); validateErr != nil {
+				diagnostics = append(diagnostics, newDiagnostic(SeverityError, compileResult.SignalPositions[m.ID][s.Name], m, s.Name, validateErr.Error()))
+				continue
 			}
 			deviceResources = append(deviceResources, deviceResource)
+			if deviceCommand != nil {
+				deviceCommands = append(deviceCommands, *deviceCommand)
+			}
 		}
 		profileDto.Name = m.Name
 		profileDto.Description = m.Description
@@ -93,23 +130,51 @@ func ConvertDBCtoProfile(data []byte) (profileDTOs []dtos.DeviceProfile, err err
 		profileDto.DeviceCommands = deviceCommands
 
 		if validateErr := common.Validate(profileDto); validateErr != nil {
-			validateErrors[profileDto.Name] = validateErr
+			diagnostics = append(diagnostics, newDiagnostic(SeverityError, compileResult.MessagePositions[m.ID], m, "", validateErr.Error()))
 		} else {
 			profileDTOs = append(profileDTOs, profileDto)
 		}
 	}
-	return
+	return profileDTOs, diagnostics, nil
+}
+
+// validateSignal validates the device resource and device command of a signal, the resource name must not be used by
+// the resources converted before
+func validateSignal(deviceResources []dtos.DeviceResource, deviceResource dtos.DeviceResource, deviceCommand *dtos.DeviceCommand) error {
+	if err := common.Validate(deviceResource); err != nil {
+		return err
+	}
+	if slices.ContainsFunc(deviceResources, func(r dtos.DeviceResource) bool { return r.Name == deviceResource.Name }) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device resource %s is duplicated", deviceResource.Name), nil)
+	}
+	if deviceCommand != nil {
+		return common.Validate(deviceCommand)
+	}
+	return nil
 }
 
+// ConvertDBCtoDevice converts each message of the DBC data into a device. The validateErrors are the errors and
+// warnings of ConvertDBCtoDeviceWithDiagnostics by message name, or by message.signal name for a signal.
 func ConvertDBCtoDevice(data []byte, args map[string]string) (deviceDTOs []dtos.Device, err error, validateErrors map[string]error) {
-	compileResult, err := Compile("", data)
+	deviceDTOs, diagnostics, err := ConvertDBCtoDeviceWithDiagnostics(data, args)
 	if err != nil {
-		return
+		return nil, err, nil
 	}
+	return deviceDTOs, nil, diagnostics.validateErrors()
+}
 
-	validateErrors = make(map[string]error, len(compileResult.Database.Messages))
+// ConvertDBCtoDeviceWithDiagnostics converts each message of the DBC data into a device, and reports the problems
+// found in the DBC data. A message is left out if its device fails the validation.
+func ConvertDBCtoDeviceWithDiagnostics(data []byte, args map[string]string) ([]dtos.Device, Diagnostics, error) {
+	compileResult, err := Compile("", data)
+	if err != nil {
+		return nil, Diagnostics{parseDiagnostic(err)}, err
+	}
 
+	var deviceDTOs []dtos.Device
+	diagnostics := compileDiagnostics(compileResult)
 	for _, m := range compileResult.Database.Messages {
+		standard := messageStandard(m, compileResult.ProtocolType)
 		deviceDTO := dtos.Device{
 			Name:           m.Name,
 			Description:    m.Description,
@@ -122,37 +187,78 @@ func ConvertDBCtoDevice(data []byte, args map[string]string) (deviceDTOs []dtos.
 					NetType:  args[NetType],
 					CommType: args[CommType],
 					Network:  args[Network],
-					Standard: J1939,
-					ID:       getOriginalCanId(m.ID),
+					Standard: standard,
+					ID:       strconv.FormatUint(uint64(dbcMessageID(m)), 10),
 					DataSize: strconv.Itoa(int(m.Length)),
 					Sender:   m.SenderNode,
 				},
 			},
-			Tags: map[string]any{
-				PGN: getPGN(m.ID),
-			},
+		}
+		if standard == J1939 {
+			deviceDTO.Tags = ParseJ1939ID(m.ID).tags()
 		}
 		if args[NetType] == NetTypeEthernet {
 			deviceDTO.Protocols[Canbus][Port] = args[Port]
 		}
+		autoEvent, ok, autoEventErr := messageAutoEvent(m, compileResult.SendTypes[m.ID])
+		if ok {
+			deviceDTO.AutoEvents = []dtos.AutoEvent{autoEvent}
+		}
 
-		validateErr := common.Validate(deviceDTO)
-		if validateErr != nil {
-			validateErrors[deviceDTO.Name] = validateErr
-		} else {
-			deviceDTOs = append(deviceDTOs, deviceDTO)
+		position := compileResult.MessagePositions[m.ID]
+		if validateErr := common.Validate(deviceDTO); validateErr != nil {
+			diagnostics = append(diagnostics, newDiagnostic(SeverityError, position, m, "", validateErr.Error()))
+			continue
+		}
+		deviceDTOs = append(deviceDTOs, deviceDTO)
+		if autoEventErr != nil {
+			// the device is still imported without the AutoEvent
+			diagnostics = append(diagnostics, newDiagnostic(SeverityWarning, position, m, "", autoEventErr.Message()))
 		}
 	}
-	return
+	return deviceDTOs, diagnostics, nil
 }
 
-func getOriginalCanId(canID uint32) string {
-	id := canID | messageIDExtendedFlag
-	return strconv.FormatUint(uint64(id), 10)
+// messageAutoEvent returns the AutoEvent of the message from its GenMsgSendType, GenMsgCycleTime and GenMsgDelayTime
+// attributes. A cyclic message is read every cycle time, and an OnChange or OnWrite message is read every delay time,
+// or every defaultOnChangeInterval without a delay time, and only sent on change. The messages which are not sent,
+// or have no send type, have no AutoEvent, and the other send types return an error.
+func messageAutoEvent(m *descriptor.Message, sendType string) (dtos.AutoEvent, bool, errors.EdgeX) {
+	switch {
+	case sendType == "" || strings.EqualFold(sendType, sendTypeNoMsgSendType) || strings.EqualFold(sendType, sendTypeNotUsed):
+		return dtos.AutoEvent{}, false, nil
+	case m.SendType == descriptor.SendTypeCyclic:
+		if m.CycleTime <= 0 {
+			return dtos.AutoEvent{}, false, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("cyclic message %s has no GenMsgCycleTime", m.Name), nil)
+		}
+		return dtos.AutoEvent{Interval: m.CycleTime.String(), SourceName: m.Name}, true, nil
+	case strings.EqualFold(sendType, sendTypeOnChange) || strings.EqualFold(sendType, sendTypeOnWrite):
+		interval := defaultOnChangeInterval
+		if m.DelayTime > 0 {
+			interval = m.DelayTime
+		}
+		return dtos.AutoEvent{Interval: interval.String(), OnChange: true, SourceName: m.Name}, true, nil
+	}
+	return dtos.AutoEvent{}, false, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("send type %s of message %s is not supported, the device has no AutoEvent", sendType, m.Name), nil)
+}
+
+// dbcMessageID returns the message ID as written in the DBC file, which has the extended flag set for extended CAN IDs
+func dbcMessageID(m *descriptor.Message) uint32 {
+	if m.IsExtended {
+		return m.ID | messageIDExtendedFlag
+	}
+	return m.ID
 }
 
-func getPGN(canID uint32) string {
-	// J1939 PGN bit start from 9, length is 18
-	pgn := (canID >> j1939PGNOffset) & j1939PGNMask
-	return fmt.Sprintf("%X", pgn)
+// messageStandard returns J1939 for the extended messages of a J1939 database, and CAN otherwise. A database is a
+// J1939 database if its ProtocolType attribute is J1939, or it has no ProtocolType as the databases converted before
+// the attribute was read were all J1939.
+func messageStandard(m *descriptor.Message, protocolType string) string {
+	if !m.IsExtended {
+		return CAN
+	}
+	if protocolType == "" || strings.EqualFold(protocolType, J1939) {
+		return J1939
+	}
+	return CAN
 }
@@ -67,7 +67,11 @@ func TestConvertDBCtoDevice(t *testing.T) {
 			},
 		},
 		Tags: map[string]any{
-			"PGN": "F003",
+			PGN:                "F003",
+			Priority:           "3",
+			SourceAddress:      "FE",
+			DestinationAddress: "FF",
+			PDUFormat:          PDU2,
 		},
 	}
 	require.EqualValues(t, expectedDeviceDTO, deviceDTOs[0], "Generated Device DTO doesn't match the expected value.")
@@ -123,6 +127,10 @@ func TestConvertDBCtoProfile(t *testing.T) {
 					ReceiverNames: []string{"Vector__XXX"},
 					MuxSignal:     false,
 					IsSigned:      false,
+					MessageID:     uint32(2364539902),
+					MessageSize:   uint8(8),
+					SenderName:    "Vector__XXX",
+					SPN:           uint32(558),
 				},
 			},
 		},
@@ -150,3 +158,66 @@ func TestConvertDBCtoProfile(t *testing.T) {
 		t.Errorf("Generated DeviceProfile DTO doesn't match the expected value.")
 	}
 }
+
+func TestConvertDBCtoDevice_AutoEvents(t *testing.T) {
+	data := []byte(`VERSION ""
+
+BS_:
+
+BU_: ECU
+
+BO_ 256 Cyclic: 1 ECU
+ SG_ A : 0|8@1+ (1,0) [0|255] "" Vector__XXX
+
+BO_ 257 Changed: 1 ECU
+ SG_ B : 0|8@1+ (1,0) [0|255] "" Vector__XXX
+
+BO_ 258 Written: 1 ECU
+ SG_ C : 0|8@1+ (1,0) [0|255] "" Vector__XXX
+
+BO_ 259 Spontaneous: 1 ECU
+ SG_ D : 0|8@1+ (1,0) [0|255] "" Vector__XXX
+
+BO_ 260 NoCycleTime: 1 ECU
+ SG_ E : 0|8@1+ (1,0) [0|255] "" Vector__XXX
+
+BO_ 261 NotSent: 1 ECU
+ SG_ F : 0|8@1+ (1,0) [0|255] "" Vector__XXX
+
+BO_ 262 NoSendType: 1 ECU
+ SG_ G : 0|8@1+ (1,0) [0|255] "" Vector__XXX
+
+BA_DEF_ BO_ "GenMsgSendType" ENUM "Cyclic","OnChange","OnWrite","Spontaneous","NoMsgSendType";
+BA_DEF_ BO_ "GenMsgCycleTime" INT 0 65535;
+BA_DEF_ BO_ "GenMsgDelayTime" INT 0 65535;
+BA_ "GenMsgSendType" BO_ 256 0;
+BA_ "GenMsgCycleTime" BO_ 256 100;
+BA_ "GenMsgSendType" BO_ 257 1;
+BA_ "GenMsgDelayTime" BO_ 257 2000;
+BA_ "GenMsgSendType" BO_ 258 2;
+BA_ "GenMsgSendType" BO_ 259 3;
+BA_ "GenMsgSendType" BO_ 260 0;
+BA_ "GenMsgSendType" BO_ 261 4;
+`)
+	deviceDTOs, err, validateErrors := ConvertDBCtoDevice(data, map[string]string{ServiceName: "device-can"})
+	require.NoError(t, err)
+	require.Len(t, deviceDTOs, 7, "the devices with unsupported send types are still converted")
+
+	autoEvents := make(map[string][]dtos.AutoEvent, len(deviceDTOs))
+	for _, d := range deviceDTOs {
+		autoEvents[d.Name] = d.AutoEvents
+	}
+	require.Equal(t, map[string][]dtos.AutoEvent{
+		"Cyclic":      {{Interval: "100ms", SourceName: "Cyclic"}},
+		"Changed":     {{Interval: "2s", OnChange: true, SourceName: "Changed"}},
+		"Written":     {{Interval: "1s", OnChange: true, SourceName: "Written"}},
+		"Spontaneous": nil,
+		"NoCycleTime": nil,
+		"NotSent":     nil,
+		"NoSendType":  nil,
+	}, autoEvents)
+
+	require.Len(t, validateErrors, 2)
+	require.Contains(t, validateErrors, "Spontaneous")
+	require.Contains(t, validateErrors, "NoCycleTime")
+}
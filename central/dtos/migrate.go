@@ -0,0 +1,289 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/v1models"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/v2models"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MigrationAction describes what happened to a v1 field during the migration
+type MigrationAction string
+
+const (
+	// MigrationDropped means the v1 field has no v3 counterpart and is lost
+	MigrationDropped MigrationAction = "dropped"
+	// MigrationDefaulted means the v3 field is not defined by the v1 profile and is set to a default value
+	MigrationDefaulted MigrationAction = "defaulted"
+	// MigrationConverted means the v1 field is converted by a heuristic, e.g. the Modbus startingAddress base change
+	MigrationConverted MigrationAction = "converted"
+	// MigrationUnsupported means the v3 field is kept by a down-conversion, but not every legacy device service reads
+	// it, e.g. the array value types of a v1 profile
+	MigrationUnsupported MigrationAction = "unsupported"
+)
+
+const (
+	startingAddress      = "startingAddress"
+	arrayValueTypeSuffix = "Array"
+	v2SetSuffix          = "_Set"
+)
+
+// MigrationEntry records a field which was dropped, defaulted or heuristically converted by MigrateProfile, or by the
+// down-conversions TransformProfileFromV3ToV2 and TransformProfileFromV3ToV1, which also record the unsupported fields
+type MigrationEntry struct {
+	Action MigrationAction
+	// Path locates the field in the source profile, e.g. deviceResources[Temperature].properties.units.type
+	Path string
+	// Original is the source value, or nil if the field is defaulted
+	Original any
+	// Migrated is the target value, or nil if the field is dropped
+	Migrated any
+	Reason   string
+}
+
+func (e MigrationEntry) String() string {
+	return fmt.Sprintf("%s %s: %v -> %v (%s)", e.Action, e.Path, e.Original, e.Migrated, e.Reason)
+}
+
+// MigrationReport lists the fields of the v1 profile which were not migrated as they are. Err is set if the migration
+// failed, in which case the entries are the ones collected before the failure.
+type MigrationReport struct {
+	Profile string
+	Entries []MigrationEntry
+	Err     errors.EdgeX
+}
+
+// Filter returns the entries of the action
+func (r MigrationReport) Filter(action MigrationAction) []MigrationEntry {
+	var entries []MigrationEntry
+	for _, entry := range r.Entries {
+		if entry.Action == action {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+func (r *MigrationReport) add(action MigrationAction, path string, original, migrated any, reason string) {
+	r.Entries = append(r.Entries, MigrationEntry{Action: action, Path: path, Original: original, Migrated: migrated, Reason: reason})
+}
+
+// MigrateProfile migrates the v1 device profile in JSON or YAML, e.g. an Edinburgh or Fuji profile, to the v3
+// DeviceProfile DTO through v1models.TransformProfileFromV1ToV2 and TransformProfileFromV2ToV3, and reports every v1
+// field which was dropped, defaulted or heuristically converted on the way, so that a bulk migration can be audited.
+func MigrateProfile(v1 []byte) (dtos.DeviceProfile, MigrationReport) {
+	var report MigrationReport
+	v1Profile, err := decodeV1Profile(v1)
+	if err != nil {
+		report.Err = errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the v1 device profile", err)
+		return dtos.DeviceProfile{}, report
+	}
+	report.Profile = v1Profile.Name
+	report.addV1Fields(v1Profile)
+
+	v2Profile, edgexErr := v1models.TransformProfileFromV1ToV2(v1Profile)
+	if edgexErr != nil {
+		report.Err = errors.NewCommonEdgeX(errors.Kind(edgexErr), "failed to transform the device profile from v1 to v2", edgexErr)
+		return dtos.DeviceProfile{}, report
+	}
+	report.addV2Conversions(v1Profile, v2Profile)
+
+	profile, edgexErr := TransformProfileFromV2ToV3(v1models.FromDeviceProfileModelToDTO(v2Profile))
+	if edgexErr != nil {
+		report.Err = errors.NewCommonEdgeX(errors.Kind(edgexErr), "failed to transform the device profile from v2 to v3", edgexErr)
+		return dtos.DeviceProfile{}, report
+	}
+	report.add(MigrationDefaulted, "apiVersion", nil, profile.ApiVersion, "v1 profiles have no apiVersion")
+	return profile, report
+}
+
+// decodeV1Profile decodes the v1 profile in JSON, or in YAML if it is not valid JSON
+func decodeV1Profile(data []byte) (v1models.DeviceProfile, error) {
+	var profile v1models.DeviceProfile
+	if json.Valid(data) {
+		err := json.Unmarshal(data, &profile)
+		return profile, err
+	}
+
+	err := yaml.Unmarshal(data, &profile)
+	if err != nil {
+		return profile, err
+	}
+	// the deprecated object and resource fields of the resource operations are only taken by UnmarshalJSON
+	for i, command := range profile.DeviceCommands {
+		for _, operations := range [][]v1models.ResourceOperation{command.Get, command.Set} {
+			for j, operation := range operations {
+				if operation.DeviceResource == "" {
+					operations[j].DeviceResource = operation.Object
+				}
+				if operation.DeviceCommand == "" {
+					operations[j].DeviceCommand = operation.Resource
+				}
+			}
+		}
+		profile.DeviceCommands[i] = command
+	}
+	return profile, nil
+}
+
+// addV1Fields adds the entries of the v1 fields which have no v2 counterpart
+func (r *MigrationReport) addV1Fields(profile v1models.DeviceProfile) {
+	if profile.Id != "" {
+		r.add(MigrationDropped, "id", profile.Id, nil, "the id is assigned by core-metadata")
+	}
+	for _, timestamp := range []struct {
+		field string
+		value int64
+	}{{"created", profile.Created}, {"modified", profile.Modified}, {"origin", profile.Origin}} {
+		if timestamp.value != 0 {
+			r.add(MigrationDropped, timestamp.field, timestamp.value, nil, "the timestamps are assigned by core-metadata")
+		}
+	}
+
+	for _, resource := range profile.DeviceResources {
+		path := fmt.Sprintf("deviceResources[%s].properties", resource.Name)
+		value, units := resource.Properties.Value, resource.Properties.Units
+		for _, property := range []struct {
+			field string
+			value string
+		}{
+			{"value.size", value.Size},
+			{"value.precision", value.Precision},
+			{"value.floatEncoding", value.FloatEncoding},
+			{"units.type", units.Type},
+			{"units.readWrite", units.ReadWrite},
+		} {
+			if property.value != "" {
+				r.add(MigrationDropped, path+"."+property.field, property.value, nil, "no such v3 resource property")
+			}
+		}
+	}
+
+	for _, command := range profile.DeviceCommands {
+		for _, ro := range resourceOperationPaths(command) {
+			if len(ro.Secondary) > 0 {
+				r.add(MigrationDropped, ro.path+".secondary", ro.Secondary, nil, "no such v3 resource operation field")
+			}
+			if ro.DeviceCommand != "" {
+				r.add(MigrationDropped, ro.path+".deviceCommand", ro.DeviceCommand, nil, "no such v3 resource operation field")
+			}
+		}
+	}
+
+	for _, command := range profile.CoreCommands {
+		path := fmt.Sprintf("coreCommands[%s]", command.Name)
+		if command.Get.Path != "" || command.Get.URL != "" || len(command.Get.Responses) > 0 {
+			r.add(MigrationDropped, path+".get", command.Get, nil, "v3 core commands are derived from the device resources and commands")
+		}
+		if command.Put.Path != "" || command.Put.URL != "" || len(command.Put.Responses) > 0 || len(command.Put.ParameterNames) > 0 {
+			r.add(MigrationDropped, path+".put", command.Put, nil, "v3 core commands are derived from the device resources and commands")
+		}
+	}
+}
+
+type resourceOperationPath struct {
+	v1models.ResourceOperation
+	path string
+}
+
+// resourceOperationPaths returns the get and set operations of the command with their paths, e.g. deviceCommands[Values].get[0]
+func resourceOperationPaths(command v1models.ProfileResource) []resourceOperationPath {
+	var operations []resourceOperationPath
+	for i, ro := range command.Get {
+		operations = append(operations, resourceOperationPath{ro, fmt.Sprintf("deviceCommands[%s].get[%d]", command.Name, i)})
+	}
+	for i, ro := range command.Set {
+		operations = append(operations, resourceOperationPath{ro, fmt.Sprintf("deviceCommands[%s].set[%d]", command.Name, i)})
+	}
+	return operations
+}
+
+// addV2Conversions adds the entries of the heuristics applied by v1models.TransformProfileFromV1ToV2
+func (r *MigrationReport) addV2Conversions(v1Profile v1models.DeviceProfile, v2Profile v2models.DeviceProfile) {
+	var exposedNames []string
+	for i, v1Resource := range v1Profile.DeviceResources {
+		v2Resource := v2Profile.DeviceResources[i]
+		path := fmt.Sprintf("deviceResources[%s]", v1Resource.Name)
+		if v1Resource.Properties.Value.Type != v2Resource.Properties.ValueType {
+			r.add(MigrationConverted, path+".properties.value.type", v1Resource.Properties.Value.Type, v2Resource.Properties.ValueType,
+				"the value type is normalized to upper camel case")
+		}
+		if v2Resource.IsHidden {
+			r.add(MigrationConverted, path+".isHidden", nil, true, "no v1 core command of the same name")
+		} else {
+			exposedNames = append(exposedNames, v2Resource.Name)
+		}
+		if original, ok := v1Resource.Attributes[startingAddress]; ok && original != fmt.Sprint(v2Resource.Attributes[startingAddress]) {
+			r.add(MigrationConverted, path+".attributes."+startingAddress, original, v2Resource.Attributes[startingAddress],
+				"the Modbus startingAddress is converted from one-based to zero-based")
+		}
+	}
+
+	for _, v2Command := range v2Profile.DeviceCommands {
+		if v2Command.IsHidden {
+			r.add(MigrationConverted, fmt.Sprintf("deviceCommands[%s].isHidden", v2Command.Name), nil, true, "no v1 core command of the same name")
+		} else {
+			exposedNames = append(exposedNames, v2Command.Name)
+		}
+	}
+	for _, v1Command := range v1Profile.DeviceCommands {
+		r.addCommandConversions(v1Command)
+	}
+
+	for _, command := range v1Profile.CoreCommands {
+		if !slices.Contains(exposedNames, command.Name) && !slices.Contains(exposedNames, command.Name+v2SetSuffix) {
+			r.add(MigrationDropped, fmt.Sprintf("coreCommands[%s]", command.Name), command.Name, nil,
+				"no device resource or command of the same name")
+		}
+	}
+}
+
+// addCommandConversions adds the entries of the v1 command whose get and set operations are merged into or split to
+// v2 commands
+func (r *MigrationReport) addCommandConversions(command v1models.ProfileResource) {
+	path := fmt.Sprintf("deviceCommands[%s]", command.Name)
+	switch {
+	case len(command.Get) > 0 && len(command.Set) > 0 && len(command.Get) != len(command.Set):
+		r.add(MigrationConverted, path+".set", command.Name, command.Name+v2SetSuffix,
+			"the get and set operations differ in length, so the set operations are split to a write-only command")
+	case len(command.Get) > 0 && len(command.Set) > 0:
+		// the read-write command takes the get operations, the set operations are dropped if they are not the same
+		for i, set := range command.Set {
+			get := command.Get[i]
+			if set.DeviceResource != get.DeviceResource || (set.Parameter != "" && set.Parameter != get.Parameter) ||
+				(len(set.Mappings) > 0 && !maps.Equal(set.Mappings, reverseMappings(get.Mappings))) {
+				r.add(MigrationDropped, fmt.Sprintf("%s.set[%d]", path, i), set, nil,
+					"the read-write command takes the get operations, which differ from the set operations")
+			}
+		}
+		return
+	}
+	if len(command.Get) == 0 || len(command.Get) != len(command.Set) {
+		for i, set := range command.Set {
+			if len(set.Mappings) > 0 {
+				r.add(MigrationConverted, fmt.Sprintf("%s.set[%d].mappings", path, i), set.Mappings, reverseMappings(set.Mappings),
+					"the mappings of the set operations are reversed to map the readings")
+			}
+		}
+	}
+}
+
+func reverseMappings(mappings map[string]string) map[string]string {
+	reversed := make(map[string]string, len(mappings))
+	for k, v := range mappings {
+		reversed[v] = k
+	}
+	return reversed
+}
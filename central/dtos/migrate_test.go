@@ -0,0 +1,137 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const mockV1ProfileYaml = `
+name: "Thermostat"
+manufacturer: "IOTech"
+model: "T1"
+labels: ["HVAC"]
+description: "Modbus thermostat"
+deviceResources:
+  - name: "Temperature"
+    description: "Room temperature"
+    attributes: { primaryTable: "HOLDING_REGISTERS", startingAddress: "1" }
+    properties:
+      value: { type: "FLOAT32", readWrite: "R", floatEncoding: "eNotation", scale: "0.1" }
+      units: { type: "String", readWrite: "R", defaultValue: "degrees C" }
+  - name: "SetPoint"
+    attributes: { primaryTable: "HOLDING_REGISTERS", startingAddress: "2" }
+    properties:
+      value: { type: "Int16", readWrite: "RW" }
+      units: { defaultValue: "degrees C" }
+  - name: "Mode"
+    attributes: { primaryTable: "HOLDING_REGISTERS", startingAddress: "3" }
+    properties:
+      value: { type: "Int16", readWrite: "RW" }
+deviceCommands:
+  - name: "Values"
+    get:
+      - { operation: "get", object: "Temperature" }
+      - { operation: "get", object: "SetPoint" }
+    set:
+      - { operation: "set", object: "SetPoint" }
+  - name: "ModeCommand"
+    get:
+      - { operation: "get", object: "Mode", mappings: { "0": "Cool", "1": "Heat" } }
+    set:
+      - { operation: "set", object: "Mode", parameter: "1", mappings: { "Cool": "0", "Heat": "1" } }
+coreCommands:
+  - name: "Values"
+    get:
+      path: "/api/v1/device/{deviceId}/Values"
+      responses:
+        - { code: "200", expectedValues: ["Temperature", "SetPoint"] }
+    put:
+      path: "/api/v1/device/{deviceId}/Values"
+      parameterNames: ["SetPoint"]
+  - name: "Temperature"
+    get:
+      path: "/api/v1/device/{deviceId}/Temperature"
+  - name: "Unknown"
+    get:
+      path: "/api/v1/device/{deviceId}/Unknown"
+`
+
+func TestMigrateProfile(t *testing.T) {
+	profile, report := MigrateProfile([]byte(mockV1ProfileYaml))
+	require.NoError(t, report.Err)
+	assert.Equal(t, "Thermostat", report.Profile)
+
+	assert.Equal(t, "Thermostat", profile.Name)
+	assert.Equal(t, common.ApiVersion, profile.ApiVersion)
+	require.Len(t, profile.DeviceResources, 3)
+	assert.Equal(t, common.ValueTypeFloat32, profile.DeviceResources[0].Properties.ValueType)
+	assert.Equal(t, "degrees C", profile.DeviceResources[0].Properties.Units)
+	assert.EqualValues(t, 0, profile.DeviceResources[0].Attributes["startingAddress"])
+	assert.False(t, profile.DeviceResources[0].IsHidden)
+	assert.True(t, profile.DeviceResources[1].IsHidden)
+	require.Len(t, profile.DeviceCommands, 3)
+	assert.Equal(t, "Values_Set", profile.DeviceCommands[1].Name)
+	assert.Equal(t, common.ReadWrite_RW, profile.DeviceCommands[2].ReadWrite)
+
+	assert.Equal(t, []MigrationEntry{
+		{Action: MigrationDropped, Path: "deviceResources[Temperature].properties.value.floatEncoding", Original: "eNotation", Reason: "no such v3 resource property"},
+		{Action: MigrationDropped, Path: "deviceResources[Temperature].properties.units.type", Original: "String", Reason: "no such v3 resource property"},
+		{Action: MigrationDropped, Path: "deviceResources[Temperature].properties.units.readWrite", Original: "R", Reason: "no such v3 resource property"},
+	}, report.Filter(MigrationDropped)[:3])
+
+	paths := make(map[string]MigrationEntry)
+	for _, entry := range report.Entries {
+		paths[entry.Path] = entry
+	}
+	assert.Equal(t, MigrationDropped, paths["coreCommands[Values].get"].Action)
+	assert.Equal(t, MigrationDropped, paths["coreCommands[Values].put"].Action)
+	assert.Equal(t, MigrationDropped, paths["coreCommands[Unknown]"].Action)
+	assert.NotContains(t, paths, "coreCommands[Temperature]")
+	assert.Equal(t, MigrationEntry{Action: MigrationConverted, Path: "deviceResources[Temperature].properties.value.type", Original: "FLOAT32",
+		Migrated: common.ValueTypeFloat32, Reason: "the value type is normalized to upper camel case"}, paths["deviceResources[Temperature].properties.value.type"])
+	assert.Equal(t, "1", paths["deviceResources[Temperature].attributes.startingAddress"].Original)
+	assert.Equal(t, 0, paths["deviceResources[Temperature].attributes.startingAddress"].Migrated)
+	assert.Equal(t, MigrationConverted, paths["deviceResources[SetPoint].isHidden"].Action)
+	assert.Equal(t, MigrationConverted, paths["deviceCommands[ModeCommand].isHidden"].Action)
+	assert.Equal(t, "Values_Set", paths["deviceCommands[Values].set"].Migrated)
+	assert.Equal(t, MigrationDropped, paths["deviceCommands[ModeCommand].set[0]"].Action)
+	assert.Equal(t, []MigrationEntry{{Action: MigrationDefaulted, Path: "apiVersion", Migrated: common.ApiVersion, Reason: "v1 profiles have no apiVersion"}},
+		report.Filter(MigrationDefaulted))
+}
+
+func TestMigrateProfile_JSON(t *testing.T) {
+	v1 := `{"name": "Switch", "deviceResources": [{"name": "Power", "properties": {"value": {"type": "Bool", "readWrite": "RW"}}}],
+		"coreCommands": [{"name": "Power", "get": {"path": "/api/v1/device/{deviceId}/Power"}}]}`
+	profile, report := MigrateProfile([]byte(v1))
+	require.NoError(t, report.Err)
+	require.Len(t, profile.DeviceResources, 1)
+	assert.False(t, profile.DeviceResources[0].IsHidden)
+	assert.Len(t, report.Filter(MigrationDropped), 1)
+	assert.Empty(t, report.Filter(MigrationConverted))
+}
+
+func TestMigrateProfile_invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		v1   string
+	}{
+		{"not a profile", "- a\n- b"},
+		{"invalid v1 profile", `{"description": "no name"}`},
+		{"invalid number", "name: Sensor\ndeviceResources:\n  - name: R1\n    properties:\n      value: { type: Int16, readWrite: R, mask: '0xFF' }\n"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, report := MigrateProfile([]byte(testCase.v1))
+			require.Error(t, report.Err)
+		})
+	}
+}
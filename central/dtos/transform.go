@@ -7,10 +7,12 @@ package dtos
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/v1models"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/v2dtos"
 )
 
@@ -150,3 +152,164 @@ func transformResourceOperationFromV2ToV3(v2ResOps []v2dtos.ResourceOperation) [
 	}
 	return ros
 }
+
+// TransformProfileFromV3ToV2 converts the v3 DeviceProfile DTO to v2 for the legacy device services. The v3-only
+// constructs are reported as the returned warnings and dropped, i.e. the Optional resource properties and the Tags of
+// the resources and commands. The value types, including the array and Object types, are kept as they are.
+func TransformProfileFromV3ToV2(profile dtos.DeviceProfile) (v2dtos.DeviceProfile, []MigrationEntry, errors.EdgeX) {
+	var report MigrationReport
+	v2Profile := v2dtos.DeviceProfile{
+		DBTimestamp: v2dtos.DBTimestamp(profile.DBTimestamp),
+		ApiVersion:  v2dtos.ApiVersion,
+		DeviceProfileBasicInfo: v2dtos.DeviceProfileBasicInfo{
+			Id:           profile.Id,
+			Name:         profile.Name,
+			Manufacturer: profile.Manufacturer,
+			Description:  profile.Description,
+			Model:        profile.Model,
+			Labels:       profile.Labels,
+		},
+		DeviceResources: transformResourceFromV3ToV2(profile.DeviceResources, &report),
+		DeviceCommands:  transformCommandFromV3ToV2(profile.DeviceCommands, &report),
+	}
+
+	err := v2Profile.Validate()
+	if err != nil {
+		return v2dtos.DeviceProfile{}, report.Entries, errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid v2 device profile after transforming from v3 to v2", err)
+	}
+	return v2Profile, report.Entries, nil
+}
+
+// TransformProfileFromV3ToV1 converts the v3 DeviceProfile DTO to v1 through TransformProfileFromV3ToV2 and
+// v1models.TransformProfileFromV2ToV1, the Modbus startingAddress attributes are converted back to one-based. The
+// Object and ObjectArray value types fail the conversion, and the other array value types are kept but reported as
+// unsupported, as only the later v1 device services read them. The warnings are the ones of TransformProfileFromV3ToV2,
+// the array value types and the startingAddress conversions.
+func TransformProfileFromV3ToV1(profile dtos.DeviceProfile) (v1models.DeviceProfile, []MigrationEntry, errors.EdgeX) {
+	edgexErr := checkObjectValueTypes(profile.DeviceResources)
+	if edgexErr != nil {
+		return v1models.DeviceProfile{}, nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
+	v2Profile, warnings, edgexErr := TransformProfileFromV3ToV2(profile)
+	if edgexErr != nil {
+		return v1models.DeviceProfile{}, warnings, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
+	v1Profile, edgexErr := v1models.TransformProfileFromV2ToV1(v2dtos.ToDeviceProfileModel(v2Profile))
+	if edgexErr != nil {
+		return v1models.DeviceProfile{}, warnings, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	edgexErr = v1models.ConvertStartingAddressToOneBased(&v1Profile)
+	if edgexErr != nil {
+		return v1models.DeviceProfile{}, warnings, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
+	report := MigrationReport{Entries: warnings}
+	for _, resource := range profile.DeviceResources {
+		if strings.HasSuffix(resource.Properties.ValueType, arrayValueTypeSuffix) {
+			report.add(MigrationUnsupported, fmt.Sprintf("deviceResources[%s].properties.valueType", resource.Name),
+				resource.Properties.ValueType, resource.Properties.ValueType, "the array value types are only read by the later v1 device services")
+		}
+	}
+	for i, resource := range v1Profile.DeviceResources {
+		if address, ok := resource.Attributes[startingAddress]; ok && address != fmt.Sprint(v2Profile.DeviceResources[i].Attributes[startingAddress]) {
+			report.add(MigrationConverted, fmt.Sprintf("deviceResources[%s].attributes.%s", resource.Name, startingAddress),
+				v2Profile.DeviceResources[i].Attributes[startingAddress], address, "the Modbus startingAddress is converted from zero-based to one-based")
+		}
+	}
+	return v1Profile, report.Entries, nil
+}
+
+// checkObjectValueTypes returns an error listing the resources of the Object and ObjectArray value types, which the v1
+// profiles can't define
+func checkObjectValueTypes(resources []dtos.DeviceResource) errors.EdgeX {
+	var names []string
+	for _, resource := range resources {
+		if resource.Properties.ValueType == common.ValueTypeObject || resource.Properties.ValueType == common.ValueTypeObjectArray {
+			names = append(names, fmt.Sprintf("%s (%s)", resource.Name, resource.Properties.ValueType))
+		}
+	}
+	if len(names) > 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("the value types of device resources %s are not supported by the v1 profiles", strings.Join(names, ", ")), nil)
+	}
+	return nil
+}
+
+// transformResourceFromV3ToV2 converts the v3 []DeviceResource DTO to v2, the dropped fields are added to the report
+func transformResourceFromV3ToV2(resources []dtos.DeviceResource, report *MigrationReport) []v2dtos.DeviceResource {
+	var v2Resources []v2dtos.DeviceResource
+	for _, resource := range resources {
+		path := fmt.Sprintf("deviceResources[%s]", resource.Name)
+		if len(resource.Tags) > 0 {
+			report.add(MigrationDropped, path+".tags", resource.Tags, nil, "the legacy profiles have no resource tags")
+		}
+		if len(resource.Properties.Optional) > 0 {
+			report.add(MigrationDropped, path+".properties.optional", resource.Properties.Optional, nil, "the legacy profiles have no optional properties")
+		}
+
+		v2Resources = append(v2Resources, v2dtos.DeviceResource{
+			Description: resource.Description,
+			Name:        resource.Name,
+			IsHidden:    resource.IsHidden,
+			Tag:         resource.Tag,
+			Properties:  transformResPropsFromV3ToV2(resource.Properties),
+			Attributes:  resource.Attributes,
+		})
+	}
+	return v2Resources
+}
+
+// transformResPropsFromV3ToV2 converts the v3 ResourceProperties DTO to v2
+func transformResPropsFromV3ToV2(props dtos.ResourceProperties) v2dtos.ResourceProperties {
+	v2Props := v2dtos.ResourceProperties{
+		ValueType:    props.ValueType,
+		ReadWrite:    props.ReadWrite,
+		Units:        props.Units,
+		DefaultValue: props.DefaultValue,
+		Assertion:    props.Assertion,
+		MediaType:    props.MediaType,
+		Minimum:      formatFloatPointer(props.Minimum),
+		Maximum:      formatFloatPointer(props.Maximum),
+		Scale:        formatFloatPointer(props.Scale),
+		Offset:       formatFloatPointer(props.Offset),
+		Base:         formatFloatPointer(props.Base),
+	}
+	if props.Mask != nil {
+		v2Props.Mask = strconv.FormatUint(*props.Mask, 10)
+	}
+	if props.Shift != nil {
+		v2Props.Shift = strconv.FormatInt(*props.Shift, 10)
+	}
+	return v2Props
+}
+
+func formatFloatPointer(value *float64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*value, 'f', -1, 64)
+}
+
+// transformCommandFromV3ToV2 converts the v3 []DeviceCommand DTO to v2, the dropped fields are added to the report
+func transformCommandFromV3ToV2(commands []dtos.DeviceCommand, report *MigrationReport) []v2dtos.DeviceCommand {
+	var v2Commands []v2dtos.DeviceCommand
+	for _, command := range commands {
+		if len(command.Tags) > 0 {
+			report.add(MigrationDropped, fmt.Sprintf("deviceCommands[%s].tags", command.Name), command.Tags, nil, "the legacy profiles have no command tags")
+		}
+
+		var ros []v2dtos.ResourceOperation
+		for _, ro := range command.ResourceOperations {
+			ros = append(ros, v2dtos.ResourceOperation(ro))
+		}
+		v2Commands = append(v2Commands, v2dtos.DeviceCommand{
+			Name:               command.Name,
+			IsHidden:           command.IsHidden,
+			ReadWrite:          command.ReadWrite,
+			ResourceOperations: ros,
+		})
+	}
+	return v2Commands
+}
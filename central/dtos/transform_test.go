@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/v2dtos"
 
@@ -109,3 +110,115 @@ func Test_transformResourceOperationFromV2ToV3(t *testing.T) {
 	result := transformResourceOperationFromV2ToV3(v2MockResourceOperations)
 	require.Equal(t, v3MockResourceOperation, result)
 }
+
+func Test_TransformProfileFromV3ToV2(t *testing.T) {
+	scale := 0.1
+	mask := uint64(255)
+	resource := mockDeviceRes
+	resource.Properties.Scale = &scale
+	resource.Properties.Mask = &mask
+	resource.Properties.Optional = map[string]any{"precision": 2}
+	resource.Tags = map[string]any{"zone": "north"}
+	command := mockDeviceCommand
+	command.Tags = map[string]any{"group": "values"}
+	profile := dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "mockPro1", Manufacturer: "DNZ"},
+		DeviceResources:        []dtos.DeviceResource{resource},
+		DeviceCommands:         []dtos.DeviceCommand{command},
+	}
+
+	result, warnings, err := TransformProfileFromV3ToV2(profile)
+	require.NoError(t, err)
+	require.Equal(t, v2dtos.ApiVersion, result.ApiVersion)
+	require.Equal(t, "DNZ", result.Manufacturer)
+	require.Equal(t, "0.1", result.DeviceResources[0].Properties.Scale)
+	require.Equal(t, "255", result.DeviceResources[0].Properties.Mask)
+	require.Equal(t, "100", result.DeviceResources[0].Properties.Minimum)
+	require.Empty(t, result.DeviceResources[0].Tags)
+	require.Equal(t, v2MockResourceOperations, result.DeviceCommands[0].ResourceOperations)
+	require.Empty(t, result.DeviceCommands[0].Tags)
+	require.Equal(t, []MigrationEntry{
+		{Action: MigrationDropped, Path: "deviceResources[mockRes1].tags", Original: resource.Tags, Reason: "the legacy profiles have no resource tags"},
+		{Action: MigrationDropped, Path: "deviceResources[mockRes1].properties.optional", Original: resource.Properties.Optional, Reason: "the legacy profiles have no optional properties"},
+		{Action: MigrationDropped, Path: "deviceCommands[mockDC1].tags", Original: command.Tags, Reason: "the legacy profiles have no command tags"},
+	}, warnings)
+}
+
+func Test_TransformProfileFromV3ToV2_objectValueType(t *testing.T) {
+	for _, valueType := range []string{common.ValueTypeObject, common.ValueTypeObjectArray, common.ValueTypeInt32Array} {
+		t.Run(valueType, func(t *testing.T) {
+			resource := mockDeviceRes
+			resource.Properties.ValueType = valueType
+			profile := dtos.DeviceProfile{
+				DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "mockPro1"},
+				DeviceResources:        []dtos.DeviceResource{resource},
+			}
+
+			result, warnings, err := TransformProfileFromV3ToV2(profile)
+			require.NoError(t, err)
+			require.Empty(t, warnings)
+			require.Equal(t, valueType, result.DeviceResources[0].Properties.ValueType)
+		})
+	}
+}
+
+func Test_TransformProfileFromV3ToV1_valueTypes(t *testing.T) {
+	tests := []struct {
+		name          string
+		valueType     string
+		expectedError bool
+	}{
+		{"Object", common.ValueTypeObject, true},
+		{"ObjectArray", common.ValueTypeObjectArray, true},
+		{"Int32Array", common.ValueTypeInt32Array, false},
+		{"StringArray", common.ValueTypeStringArray, false},
+		{"Float64Array", common.ValueTypeFloat64Array, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := mockDeviceRes
+			resource.Properties.ValueType = tt.valueType
+			profile := dtos.DeviceProfile{
+				DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "mockPro1"},
+				DeviceResources:        []dtos.DeviceResource{resource},
+			}
+
+			result, warnings, err := TransformProfileFromV3ToV1(profile)
+			if tt.expectedError {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), fmt.Sprintf("mockRes1 (%s)", tt.valueType))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.valueType, result.DeviceResources[0].Properties.Value.Type)
+			require.Equal(t, []MigrationEntry{
+				{Action: MigrationUnsupported, Path: "deviceResources[mockRes1].properties.valueType", Original: tt.valueType, Migrated: tt.valueType,
+					Reason: "the array value types are only read by the later v1 device services"},
+			}, warnings)
+		})
+	}
+}
+
+func Test_TransformProfileFromV3ToV1(t *testing.T) {
+	resource := mockDeviceRes
+	resource.Attributes = map[string]any{"primaryTable": "HOLDING_REGISTERS", "startingAddress": 0}
+	resource.Tags = map[string]any{"zone": "north"}
+	profile := dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "mockPro1"},
+		DeviceResources:        []dtos.DeviceResource{resource},
+		DeviceCommands:         []dtos.DeviceCommand{mockDeviceCommand},
+	}
+
+	result, warnings, err := TransformProfileFromV3ToV1(profile)
+	require.NoError(t, err)
+	require.Equal(t, "mockPro1", result.Name)
+	require.Equal(t, "1", result.DeviceResources[0].Attributes["startingAddress"])
+	require.Empty(t, result.DeviceResources[0].Tags)
+	require.Len(t, result.DeviceCommands[0].Get, 1)
+	require.Len(t, result.CoreCommands, 2)
+	require.Equal(t, []MigrationEntry{
+		{Action: MigrationDropped, Path: "deviceResources[mockRes1].tags", Original: resource.Tags, Reason: "the legacy profiles have no resource tags"},
+		{Action: MigrationConverted, Path: "deviceResources[mockRes1].attributes.startingAddress", Original: 0, Migrated: "1",
+			Reason: "the Modbus startingAddress is converted from zero-based to one-based"},
+	}, warnings)
+}
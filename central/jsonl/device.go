@@ -0,0 +1,135 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonl
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/central/xlsx"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+const (
+	// maxJSONLLineSize is the size of the longest line of a JSON-lines file
+	maxJSONLLineSize = 1024 * 1024
+	// devicesName is the sheet name of the diagnostics, the same as the Devices sheet of the xlsx file
+	devicesName = "Devices"
+)
+
+// deviceJSONL converts the devices JSON-lines file to Device DTOs
+type deviceJSONL struct {
+	reader         io.Reader
+	devices        []*dtos.Device
+	validateErrors map[string]error
+	diagnostics    []xlsx.CellDiagnostic
+}
+
+var (
+	_ xlsx.Converter[[]*dtos.Device] = (*deviceJSONL)(nil)
+	_ xlsx.DiagnosticsConverter      = (*deviceJSONL)(nil)
+)
+
+// ConvertDeviceJSONL converts the devices JSON-lines file, which has a Device DTO per line, to Device DTOs. The devices
+// are validated as the devices of the xlsx and CSV files, and the validate diagnostics locate the errors by line, i.e.
+// the Row of a diagnostic is the line number and its Header is the invalid Device field.
+func ConvertDeviceJSONL(devices io.Reader) (xlsx.Converter[[]*dtos.Device], errors.EdgeX) {
+	deviceJ := NewDeviceJSONL(devices)
+	err := deviceJ.ConvertToDTO()
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return deviceJ, nil
+}
+
+// NewDeviceJSONL creates the Converter of the JSON-lines file without converting it, so the validate diagnostics can
+// still be read if ConvertToDTO fails
+func NewDeviceJSONL(devices io.Reader) xlsx.Converter[[]*dtos.Device] {
+	return &deviceJSONL{reader: devices, validateErrors: make(map[string]error)}
+}
+
+// ConvertToDTO parses the lines of the JSON-lines file to Device DTOs, the blank lines are skipped
+func (deviceJ *deviceJSONL) ConvertToDTO() errors.EdgeX {
+	scanner := bufio.NewScanner(deviceJ.reader)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxJSONLLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		var device dtos.Device
+		if err := json.Unmarshal(data, &device); err != nil {
+			deviceJ.diagnostics = append(deviceJ.diagnostics, lineDiagnostic(line, "", err.Error()))
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to unmarshal line %d into Device DTO", line), err)
+		}
+
+		err := common.Validate(device)
+		if err == nil {
+			err = device.ValidateProtocols()
+		}
+		if err != nil {
+			deviceJ.validateErrors[device.Name] = err
+			deviceJ.diagnostics = append(deviceJ.diagnostics, validateLineDiagnostics(line, device, err)...)
+			continue
+		}
+		deviceJ.devices = append(deviceJ.devices, &device)
+	}
+	if err := scanner.Err(); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to read the %s JSON-lines file", devicesName), err)
+	}
+	return nil
+}
+
+func (deviceJ *deviceJSONL) GetDTOs() []*dtos.Device {
+	return deviceJ.devices
+}
+
+func (deviceJ *deviceJSONL) GetValidateErrors() map[string]error {
+	return deviceJ.validateErrors
+}
+
+func (deviceJ *deviceJSONL) GetValidateDiagnostics() []xlsx.CellDiagnostic {
+	return deviceJ.diagnostics
+}
+
+func lineDiagnostic(line int, field string, reason string) xlsx.CellDiagnostic {
+	return xlsx.CellDiagnostic{Sheet: devicesName, Row: line, Header: field, Reason: reason}
+}
+
+// validateLineDiagnostics returns the diagnostics of the validation error of the device of the line, one per invalid
+// field
+func validateLineDiagnostics(line int, device dtos.Device, err error) []xlsx.CellDiagnostic {
+	var diagnostics []xlsx.CellDiagnostic
+	for _, fieldError := range common.ValidateFields(device) {
+		d := lineDiagnostic(line, fieldError.Field, fieldError.Message)
+		d.FieldError = &fieldError
+		diagnostics = append(diagnostics, d)
+	}
+	if len(diagnostics) == 0 {
+		// the error is not about a single field, e.g. an invalid protocol property
+		diagnostics = append(diagnostics, lineDiagnostic(line, "", err.Error()))
+	}
+	return diagnostics
+}
+
+// ConvertToJSONL writes the Device DTOs to w as the devices JSON-lines file, which has a Device DTO per line and can be
+// read back by ConvertDeviceJSONL
+func ConvertToJSONL(w io.Writer, devices []dtos.Device) errors.EdgeX {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	for _, device := range devices {
+		if err := encoder.Encode(device); err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to write device %s to the %s JSON-lines file", device.Name, devicesName), err)
+		}
+	}
+	return nil
+}
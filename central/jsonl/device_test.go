@@ -0,0 +1,90 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonl
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/central/xlsx"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertToJSONL_RoundTrip(t *testing.T) {
+	devices := []dtos.Device{
+		{
+			Name:           "Sensor1",
+			ServiceName:    "device-modbus",
+			ProfileName:    "tcp-profile",
+			Labels:         []string{"a", "b"},
+			AdminState:     "UNLOCKED",
+			OperatingState: "UP",
+			Protocols:      map[string]dtos.ProtocolProperties{"modbus-tcp": {common.ModbusAddress: "192.168.0.1", common.ModbusPort: "502"}},
+			Properties:     map[string]any{common.ProtocolName: "modbus-tcp"},
+			Tags:           map[string]any{"MachineType": "<Motor>", "Line": map[string]any{"Zone": "A"}},
+			AutoEvents:     []dtos.AutoEvent{{Interval: "5s", OnChange: true, SourceName: "temperature"}},
+		},
+		{Name: "Sensor2", ServiceName: "device-modbus", ProfileName: "tcp-profile", AdminState: "LOCKED", OperatingState: "DOWN",
+			Protocols: map[string]dtos.ProtocolProperties{"other": {"Address": "10.0.0.1"}}},
+	}
+
+	var buffer bytes.Buffer
+	require.NoError(t, ConvertToJSONL(&buffer, devices))
+	assert.Equal(t, 2, strings.Count(buffer.String(), "\n"))
+	assert.Contains(t, buffer.String(), `"<Motor>"`)
+
+	converter, err := ConvertDeviceJSONL(&buffer)
+	require.NoError(t, err)
+	require.Empty(t, converter.GetValidateErrors())
+	require.Len(t, converter.GetDTOs(), len(devices))
+	for i, device := range converter.GetDTOs() {
+		assert.Equal(t, devices[i], *device)
+	}
+}
+
+func TestConvertDeviceJSONL_Diagnostics(t *testing.T) {
+	devices := `{"name":"Sensor1","serviceName":"device-modbus","profileName":"tcp-profile","adminState":"UNLOCKED","operatingState":"UP","protocols":{"modbus-tcp":{"Address":"192.168.0.1"}}}
+
+{"name":"Sensor2","serviceName":"device-modbus","profileName":"tcp-profile","adminState":"BROKEN","operatingState":"UP","protocols":{"modbus-tcp":{"Address":"192.168.0.2"}}}
+{"name":"Sensor3","serviceName":"device-modbus","profileName":"tcp-profile","adminState":"UNLOCKED","operatingState":"UP","protocols":{"modbus-tcp":{"Address":"192.168.0.3","Port":"65536"}}}
+`
+	converter, err := ConvertDeviceJSONL(strings.NewReader(devices))
+	require.NoError(t, err)
+	require.Len(t, converter.GetDTOs(), 1)
+	assert.Equal(t, "Sensor1", converter.GetDTOs()[0].Name)
+	require.Len(t, converter.GetValidateErrors(), 2)
+	assert.Contains(t, converter.GetValidateErrors(), "Sensor2")
+	assert.Contains(t, converter.GetValidateErrors(), "Sensor3")
+
+	require.Implements(t, (*xlsx.DiagnosticsConverter)(nil), converter)
+	diagnostics := converter.(xlsx.DiagnosticsConverter).GetValidateDiagnostics()
+	require.Len(t, diagnostics, 2)
+	assert.Equal(t, 3, diagnostics[0].Row)
+	assert.Equal(t, "AdminState", diagnostics[0].Header)
+	require.NotNil(t, diagnostics[0].FieldError)
+	assert.Equal(t, 4, diagnostics[1].Row)
+	assert.Contains(t, diagnostics[1].Reason, common.ModbusPort)
+}
+
+func TestConvertDeviceJSONL_Error(t *testing.T) {
+	devices := `{"name":"Sensor1","serviceName":"device-modbus","profileName":"tcp-profile","adminState":"UNLOCKED","operatingState":"UP","protocols":{"modbus-tcp":{"Address":"192.168.0.1"}}}
+not json
+`
+	converter := NewDeviceJSONL(strings.NewReader(devices))
+	require.Error(t, converter.ConvertToDTO())
+	diagnostics := converter.(xlsx.DiagnosticsConverter).GetValidateDiagnostics()
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, 2, diagnostics[0].Row)
+	assert.Equal(t, "Devices!2: "+diagnostics[0].Reason, diagnostics[0].Error())
+
+	_, err := ConvertDeviceJSONL(strings.NewReader(devices))
+	require.Error(t, err)
+}
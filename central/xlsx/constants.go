@@ -54,6 +54,10 @@ const (
 	adminState         = "AdminState"
 	operatingState     = "OperatingState"
 	onChange           = "OnChange"
+	onChangeThreshold  = "OnChangeThreshold"
+	optional           = "Optional"
+	parent             = "Parent"
+	tag                = "Tag"
 )
 
 // constants relates to the Device protocols
@@ -61,4 +65,9 @@ const (
 	modbusRTU = "modbus-rtu"
 )
 
-const mappingPathSeparator = "."
+// MappingPathSeparator separates the levels of the Path defined in the MappingTable sheet, e.g. protocols.modbus-rtu.Address
+const MappingPathSeparator = "."
+
+// MappingPathWildcard stands for the protocol of the Devices row in the protocols.*.<property> paths defined in the
+// MappingTable sheet, e.g. protocols.*.Address is the Address of the modbus-rtu protocol for a modbus-rtu device
+const MappingPathWildcard = "*"
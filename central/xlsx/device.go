@@ -26,6 +26,8 @@ type baseXlsx struct {
 	xlsFile        *excelize.File
 	fieldMappings  map[string]mappingField // fieldMappings defines all the device fields with default values defined in the xlsx
 	validateErrors map[string]error
+	diagnostics    []CellDiagnostic
+	roundTrip      bool // roundTrip is set if the xlsx is written in the round-trip mode
 }
 
 // deviceXlsx stores the worksheets processed result and the converted Device DTOs
@@ -50,6 +52,7 @@ func newDeviceXlsx(file io.Reader) (Converter[[]*dtos.Device], errors.EdgeX) {
 			xlsFile:        f,
 			fieldMappings:  fieldMappings,
 			validateErrors: make(map[string]error),
+			roundTrip:      isRoundTripXlsx(f),
 		},
 	}, nil
 }
@@ -74,8 +77,10 @@ func (deviceXlsx *deviceXlsx) ConvertToDTO() errors.EdgeX {
 
 	// checks at least 2 rows exists in the Devices sheet (1 header and 1 data row)
 	// and parses the header row
+	var headerColCount int
 	if len(rows) >= 2 {
 		header = rows[0]
+		headerColCount = len(header)
 		edgexErr = deviceXlsx.parseDevicesHeader(&header, len(rows))
 		if edgexErr != nil {
 			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse the header row from %s worksheet", devicesSheetName), err)
@@ -89,6 +94,7 @@ func (deviceXlsx *deviceXlsx) ConvertToDTO() errors.EdgeX {
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to retrieve all rows from %s worksheet after inserting misshing columns", devicesSheetName), err)
 	}
+	deviceXlsx.clearInsertedProtocolDefaults(rows, header, headerColCount)
 
 	// parse the device data rows
 	for rowIndex, row := range rows {
@@ -96,9 +102,19 @@ func (deviceXlsx *deviceXlsx) ConvertToDTO() errors.EdgeX {
 			continue
 		}
 
-		convertedDevice := dtos.Device{Properties: map[string]any{common.ProtocolName: protocol}}
-		_, err = readStruct(&convertedDevice, header, row, deviceXlsx.fieldMappings)
+		// GetRows omits the trailing empty cells of the row, pad them so that the default values also apply to them
+		if len(row) < len(header) {
+			row = append(row, make([]string, len(header)-len(row))...)
+		}
+
+		record := sheetRecord{sheet: devicesSheetName, header: header, fieldMappings: deviceXlsx.fieldMappings, index: rowIndex}
+		convertedDevice := dtos.Device{}
+		if protocol != "" {
+			convertedDevice.Properties = map[string]any{common.ProtocolName: protocol}
+		}
+		_, err = readStruct(&convertedDevice, header, row, deviceXlsx.fieldMappings, deviceXlsx.roundTrip)
 		if err != nil {
+			deviceXlsx.diagnostics = append(deviceXlsx.diagnostics, record.parseDiagnostic(err))
 			return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to unmarshal an xlsx row into Device DTO", err)
 		}
 
@@ -110,9 +126,16 @@ func (deviceXlsx *deviceXlsx) ConvertToDTO() errors.EdgeX {
 		}
 
 		// validate the device DTO
+		var diagnostics []CellDiagnostic
 		err = common.Validate(convertedDevice)
+		if err != nil {
+			diagnostics = record.validateDiagnostics(convertedDevice, err)
+		} else if err = convertedDevice.ValidateProtocols(); err != nil {
+			diagnostics = record.protocolDiagnostics(convertedDevice.Protocols, err)
+		}
 		if err != nil {
 			deviceXlsx.validateErrors[convertedDevice.Name] = err
+			deviceXlsx.diagnostics = append(deviceXlsx.diagnostics, diagnostics...)
 		} else {
 			deviceXlsx.devices = append(deviceXlsx.devices, &convertedDevice)
 		}
@@ -152,6 +175,29 @@ func (deviceXlsx *deviceXlsx) parseDevicesHeader(header *[]string, rowCount int)
 	return nil
 }
 
+// clearInsertedProtocolDefaults clears the default values of the columns inserted by parseDevicesHeader from the rows
+// of devices of another protocol, so that a sheet of mixed protocols only gets the defaults of its own protocol
+func (deviceXlsx *deviceXlsx) clearInsertedProtocolDefaults(rows [][]string, header []string, headerColCount int) {
+	for colIndex := headerColCount; colIndex < len(header); colIndex++ {
+		mapping, ok := deviceXlsx.fieldMappings[header[colIndex]]
+		if !ok {
+			continue
+		}
+		mappingPath := strings.Split(mapping.path, MappingPathSeparator)
+		if len(mappingPath) < 3 || !strings.EqualFold(mappingPath[0], protocols) {
+			continue
+		}
+		for rowIndex, row := range rows {
+			if rowIndex == 0 || colIndex >= len(row) {
+				continue
+			}
+			if isOtherProtocol(readRowProtocol(row, header, deviceXlsx.fieldMappings), mappingPath[1]) {
+				row[colIndex] = ""
+			}
+		}
+	}
+}
+
 // convertAutoEvents parses the AutoEvents sheet and convert the rows to AutoEvent DTOs
 func (deviceXlsx *deviceXlsx) convertAutoEvents() errors.EdgeX {
 	var header []string
@@ -193,9 +239,11 @@ OUTER:
 			continue
 		}
 
+		record := sheetRecord{sheet: autoEventsSheetName, header: header, fieldMappings: deviceXlsx.fieldMappings, index: rowIndex}
 		autoEvent := dtos.AutoEvent{}
-		deviceNameResult, edgexErr := readStruct(&autoEvent, header, row, deviceXlsx.fieldMappings)
+		deviceNameResult, edgexErr := readStruct(&autoEvent, header, row, deviceXlsx.fieldMappings, deviceXlsx.roundTrip)
 		if edgexErr != nil {
+			deviceXlsx.diagnostics = append(deviceXlsx.diagnostics, record.parseDiagnostic(edgexErr))
 			return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to unmarshal an excel row into AutoEvent DTO", err)
 		}
 
@@ -208,6 +256,7 @@ OUTER:
 		// validate the AutoEvent DTO
 		err = common.Validate(autoEvent)
 		if err != nil {
+			deviceXlsx.diagnostics = append(deviceXlsx.diagnostics, record.validateDiagnostics(autoEvent, err)...)
 			for _, deviceName := range deviceNames {
 				// find the matched device DTO index equals to the "Reference Device Name" on the AutoEvents row
 				idx := slices.IndexFunc(deviceXlsx.devices, func(d *dtos.Device) bool { return d.Name == deviceName })
@@ -6,7 +6,9 @@
 package xlsx
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
@@ -378,3 +380,107 @@ func Test_GetValidateErrors(t *testing.T) {
 		require.Fail(t, "Expected device validation error not found")
 	}
 }
+
+func createMixedProtocolsXlsx(devicesRows [][]any) (*bytes.Buffer, error) {
+	f, err := mockExcelFile([]string{devicesSheetName, mappingTableSheetName, autoEventsSheetName})
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	mappingRows := [][]any{
+		{"Object", "Path", "Default Value"},
+		{"AdminState", "adminState", "UNLOCKED"},
+		{"OperatingState", "operatingState", "UP"},
+		{"ProtocolName", "properties.IOTech_ProtocolName", common.ModbusTcp},
+		{"Address", "protocols.*.Address", ""},
+		{"Port", "protocols.*.Port", ""},
+		{"UnitID", "protocols.modbus-tcp.UnitID", "1"},
+		{"DeviceInstance", "protocols.BACnet-IP.DeviceInstance", ""},
+	}
+	for i, row := range mappingRows {
+		if err = f.SetSheetRow(mappingTableSheetName, fmt.Sprintf("A%d", i+1), &row); err != nil {
+			return nil, err
+		}
+	}
+	for i, row := range devicesRows {
+		if err = f.SetSheetRow(devicesSheetName, fmt.Sprintf("A%d", i+1), &row); err != nil {
+			return nil, err
+		}
+	}
+	if err = f.SetSheetRow(autoEventsSheetName, "A1", &[]any{"Interval", "OnChange", "SourceName", refDeviceName}); err != nil {
+		return nil, err
+	}
+	return f.WriteToBuffer()
+}
+
+func Test_convertToDTO_mixedProtocols(t *testing.T) {
+	buffer, err := createMixedProtocolsXlsx([][]any{
+		{"Name", "ServiceName", "ProfileName", "ProtocolName", "Address", "Port", "DeviceInstance"},
+		{"tcp-device", "device-modbus", "tcp-profile", "", "10.0.0.1", "502"},
+		{"bacnet-device", "device-bacnet", "bacnet-profile", common.BacnetIP, "10.0.0.2", "47808", "1234"},
+	})
+	require.NoError(t, err)
+
+	deviceX, err := newDeviceXlsx(buffer)
+	require.NoError(t, err)
+	defer deviceX.(*deviceXlsx).xlsFile.Close()
+	require.NoError(t, deviceX.ConvertToDTO())
+	require.Empty(t, deviceX.GetValidateErrors())
+
+	devices := deviceX.GetDTOs()
+	require.Len(t, devices, 2)
+	require.Equal(t, map[string]dtos.ProtocolProperties{
+		common.ModbusTcp: {common.ModbusAddress: "10.0.0.1", common.ModbusPort: 502, common.ModbusUnitID: 1},
+	}, devices[0].Protocols)
+	require.Equal(t, common.ModbusTcp, devices[0].Properties[common.ProtocolName])
+	// the default UnitID of modbus-tcp doesn't apply to the BACnet-IP device
+	require.Equal(t, map[string]dtos.ProtocolProperties{
+		common.BacnetIP: {common.BacnetAddress: "10.0.0.2", common.BacnetPort: 47808, common.BacnetDeviceInstance: 1234},
+	}, devices[1].Protocols)
+	require.Equal(t, common.BacnetIP, devices[1].Properties[common.ProtocolName])
+
+	// write the devices back to the same template and read them again
+	template, err := createMixedProtocolsXlsx([][]any{
+		{"Name", "ServiceName", "ProfileName", "AdminState", "OperatingState", "ProtocolName", "Address", "Port", "UnitID", "DeviceInstance"},
+	})
+	require.NoError(t, err)
+	var exported []dtos.Device
+	for _, device := range devices {
+		exported = append(exported, *device)
+	}
+	xlsxWriter, err := newXlsxWriter(exported, template)
+	require.NoError(t, err)
+	defer xlsxWriter.Close()
+	require.NoError(t, xlsxWriter.ConvertToXlsx())
+	var output bytes.Buffer
+	require.NoError(t, xlsxWriter.Write(&output))
+
+	reimported, err := newDeviceXlsx(&output)
+	require.NoError(t, err)
+	defer reimported.(*deviceXlsx).xlsFile.Close()
+	require.NoError(t, reimported.ConvertToDTO())
+	require.Empty(t, reimported.GetValidateErrors())
+	require.Equal(t, devices, reimported.GetDTOs())
+}
+
+func Test_convertToDTO_wildcardWithoutProtocol(t *testing.T) {
+	f, err := mockExcelFile([]string{devicesSheetName, mappingTableSheetName})
+	require.NoError(t, err)
+	defer f.Close()
+	require.NoError(t, f.SetSheetRow(mappingTableSheetName, "A1", &[]any{"Object", "Path", "Default Value"}))
+	require.NoError(t, f.SetSheetRow(mappingTableSheetName, "A2", &[]any{"Address", "protocols.*.Address", ""}))
+	require.NoError(t, f.SetSheetRow(devicesSheetName, "A1", &[]any{"Name", "Address"}))
+	require.NoError(t, f.SetSheetRow(devicesSheetName, "A2", &[]any{"device", "10.0.0.1"}))
+	buffer, err := f.WriteToBuffer()
+	require.NoError(t, err)
+
+	deviceX, err := newDeviceXlsx(buffer)
+	require.NoError(t, err)
+	defer deviceX.(*deviceXlsx).xlsFile.Close()
+	require.Error(t, deviceX.ConvertToDTO())
+	diagnostics := deviceX.(DiagnosticsConverter).GetValidateDiagnostics()
+	require.Len(t, diagnostics, 1)
+	require.Equal(t, devicesSheetName, diagnostics[0].Sheet)
+	require.Equal(t, "B2", diagnostics[0].Cell())
+}
@@ -29,7 +29,8 @@ var requiredProfileSheets = []string{deviceInfoSheetName, deviceResourceSheetNam
 // deviceProfileXlsx stores the worksheets processed result and the converted DeviceProfile DTO
 type deviceProfileXlsx struct {
 	baseXlsx
-	deviceProfile *dtos.DeviceProfile
+	deviceProfile    *dtos.DeviceProfile
+	deviceInfoHeader []string
 }
 
 func newDeviceProfileXlsx(file io.Reader) (Converter[*dtos.DeviceProfile], errors.EdgeX) {
@@ -48,6 +49,7 @@ func newDeviceProfileXlsx(file io.Reader) (Converter[*dtos.DeviceProfile], error
 			xlsFile:        f,
 			fieldMappings:  fieldMappings,
 			validateErrors: make(map[string]error),
+			roundTrip:      isRoundTripXlsx(f),
 		},
 	}, nil
 }
@@ -85,6 +87,7 @@ func (dpXlsx *deviceProfileXlsx) ConvertToDTO() errors.EdgeX {
 	err := convertedProfile.Validate()
 	if err != nil {
 		dpXlsx.validateErrors[validateErrProfilePrefix+convertedProfile.DeviceProfileBasicInfo.Name] = err
+		dpXlsx.diagnostics = append(dpXlsx.diagnostics, dpXlsx.deviceInfoRecord().validateDiagnostics(convertedProfile, err)...)
 	} else if dpXlsx.validateErrors != nil {
 		dpXlsx.deviceProfile = convertedProfile
 	}
@@ -103,13 +106,15 @@ func (dpXlsx *deviceProfileXlsx) convertDeviceInfo(convertedProfile *dtos.Device
 	// and parses the header column
 	if len(cols) >= 2 {
 		header = cols[0]
+		dpXlsx.deviceInfoHeader = header
 	} else {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("at least 2 columns need to be defined in %s worksheet", deviceInfoSheetName), nil)
 	}
 
 	// parse the DeviceInfo data column
-	_, err = readStruct(convertedProfile, header, cols[1], dpXlsx.fieldMappings)
+	_, err = readStruct(convertedProfile, header, cols[1], dpXlsx.fieldMappings, dpXlsx.roundTrip)
 	if err != nil {
+		dpXlsx.diagnostics = append(dpXlsx.diagnostics, dpXlsx.deviceInfoRecord().parseDiagnostic(err))
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to unmarshal an xlsx column into DeviceProfile DTO", err)
 	}
 	return nil
@@ -147,9 +152,11 @@ func (dpXlsx *deviceProfileXlsx) convertDeviceResources(convertedProfile *dtos.D
 			continue
 		}
 
+		record := sheetRecord{sheet: deviceResourceSheetName, header: header, index: rowIndex}
 		convertedDR := dtos.DeviceResource{}
-		_, err = readStruct(&convertedDR, header, row, dpXlsx.fieldMappings)
+		_, err = readStruct(&convertedDR, header, row, dpXlsx.fieldMappings, dpXlsx.roundTrip)
 		if err != nil {
+			dpXlsx.diagnostics = append(dpXlsx.diagnostics, record.parseDiagnostic(err))
 			return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to unmarshal an xlsx row into DeviceResource DTO", err)
 		}
 
@@ -157,6 +164,7 @@ func (dpXlsx *deviceProfileXlsx) convertDeviceResources(convertedProfile *dtos.D
 		err = convertedDR.Validate()
 		if err != nil {
 			dpXlsx.validateErrors[validateErrResourcePrefix+convertedDR.Name] = err
+			dpXlsx.diagnostics = append(dpXlsx.diagnostics, record.validateDiagnostics(convertedDR, err)...)
 		} else {
 			convertedProfile.DeviceResources = append(convertedProfile.DeviceResources, convertedDR)
 		}
@@ -218,9 +226,11 @@ func (dpXlsx *deviceProfileXlsx) convertDeviceCommands(convertedProfile *dtos.De
 
 		if nonEmptyCol {
 			// parse the DeviceCommand data columns
+			record := sheetRecord{sheet: deviceCommandSheetName, header: header, index: colIndex, byColumn: true}
 			convertedDC := dtos.DeviceCommand{}
-			_, err = readStruct(&convertedDC, header, col, dpXlsx.fieldMappings)
+			_, err = readStruct(&convertedDC, header, col, dpXlsx.fieldMappings, dpXlsx.roundTrip)
 			if err != nil {
+				dpXlsx.diagnostics = append(dpXlsx.diagnostics, record.parseDiagnostic(err))
 				return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to unmarshal an xlsx column into DeviceCommand DTO", err)
 			}
 
@@ -228,6 +238,7 @@ func (dpXlsx *deviceProfileXlsx) convertDeviceCommands(convertedProfile *dtos.De
 			err = common.Validate(convertedDC)
 			if err != nil {
 				dpXlsx.validateErrors[validateErrCommandPrefix+convertedDC.Name] = err
+				dpXlsx.diagnostics = append(dpXlsx.diagnostics, record.validateDiagnostics(convertedDC, err)...)
 			} else {
 				convertedProfile.DeviceCommands = append(convertedProfile.DeviceCommands, convertedDC)
 			}
@@ -236,6 +247,11 @@ func (dpXlsx *deviceProfileXlsx) convertDeviceCommands(convertedProfile *dtos.De
 	return nil
 }
 
+// deviceInfoRecord returns the data column of the DeviceInfo sheet
+func (dpXlsx *deviceProfileXlsx) deviceInfoRecord() sheetRecord {
+	return sheetRecord{sheet: deviceInfoSheetName, header: dpXlsx.deviceInfoHeader, index: 1, byColumn: true}
+}
+
 func (dpXlsx *deviceProfileXlsx) GetDTOs() *dtos.DeviceProfile {
 	return dpXlsx.deviceProfile
 }
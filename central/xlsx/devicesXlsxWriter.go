@@ -51,8 +51,8 @@ func (deviceWriter *devicesXlsxWriter) Write(w io.Writer) errors.EdgeX {
 	return nil
 }
 
-// closeXlsxFile closes the xlsx file reader
-func (deviceWriter *devicesXlsxWriter) closeXlsxFile() errors.EdgeX {
+// Close closes the xlsx file reader
+func (deviceWriter *devicesXlsxWriter) Close() errors.EdgeX {
 	err := deviceWriter.xlsFile.Close()
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindServerError, "failed to close xlsx file", err)
@@ -94,7 +94,7 @@ func (deviceWriter *devicesXlsxWriter) convertDevices() errors.EdgeX {
 				case strings.ToLower(description):
 					cell = device.Description
 				case strings.ToLower(common.Labels):
-					cell = strings.Join(device.Labels, ",")
+					cell = device.Labels
 				case strings.ToLower(adminState):
 					cell = device.AdminState
 				case strings.ToLower(operatingState):
@@ -103,6 +103,8 @@ func (deviceWriter *devicesXlsxWriter) convertDevices() errors.EdgeX {
 					cell = device.ServiceName
 				case strings.ToLower(common.ProfileName):
 					cell = device.ProfileName
+				case strings.ToLower(parent):
+					cell = device.Parent
 				default:
 					continue
 				}
@@ -111,7 +113,7 @@ func (deviceWriter *devicesXlsxWriter) convertDevices() errors.EdgeX {
 					// if header matches the MappingTable Object field from worksheet
 					// check the Device Protocols/Properties/Tags map field from DTO and get the value
 					if headerCell == objectField {
-						mappingPath := strings.Split(mapping.path, mappingPathSeparator)
+						mappingPath := strings.Split(mapping.path, MappingPathSeparator)
 						mappingPathLength := len(mappingPath)
 						if mappingPathLength < 2 {
 							// invalid path defined in the MappingTable sheet, at least 1 dot needs to exist, e.g., properties.IOTech_ProtocolName
@@ -126,7 +128,12 @@ func (deviceWriter *devicesXlsxWriter) convertDevices() errors.EdgeX {
 								continue OUTER
 							}
 
-							if topLevelPrtProp, ok := device.Protocols[mappingPath[1]]; ok {
+							protocol := mappingPath[1]
+							if protocol == MappingPathWildcard {
+								// protocols.*.<property> paths are the properties of the protocol of the device
+								protocol = deviceWriter.deviceProtocol(device)
+							}
+							if topLevelPrtProp, ok := device.Protocols[protocol]; ok {
 								cell, err = getNestedMapValue(mappingPath[2:], topLevelPrtProp)
 								if err != nil {
 									return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to get '%s' field from Protocols map in %s worksheet", headerCell, devicesSheetName), err)
@@ -151,6 +158,13 @@ func (deviceWriter *devicesXlsxWriter) convertDevices() errors.EdgeX {
 				}
 			}
 
+			if cell == nil {
+				continue
+			}
+			cell, err = deviceWriter.cellValue(cell, field.Kind() == reflect.Invalid)
+			if err != nil {
+				return errors.NewCommonEdgeXWrapper(err)
+			}
 			if cell == "" {
 				continue
 			}
@@ -170,6 +184,15 @@ func (deviceWriter *devicesXlsxWriter) convertDevices() errors.EdgeX {
 	return nil
 }
 
+// deviceProtocol returns the protocol name of the device which is written to the ProtocolName column, or the default
+// value of the ProtocolName object defined in the MappingTable sheet
+func (deviceWriter *devicesXlsxWriter) deviceProtocol(device dtos.Device) string {
+	if protocol, ok := device.Properties[common.ProtocolName].(string); ok && protocol != "" {
+		return protocol
+	}
+	return deviceWriter.fieldMappings[protocolName].defaultValue
+}
+
 // convertAutoEvents converts the []AutoEvent DTO into the AutoEvents worksheet
 func (deviceWriter *devicesXlsxWriter) convertAutoEvents() errors.EdgeX {
 	f := deviceWriter.xlsFile
@@ -204,6 +227,8 @@ func (deviceWriter *devicesXlsxWriter) convertAutoEvents() errors.EdgeX {
 						cell = autoEvent.Interval
 					case strings.ToLower(onChange):
 						cell = autoEvent.OnChange
+					case strings.ToLower(onChangeThreshold):
+						cell = autoEvent.OnChangeThreshold
 					case strings.ToLower(common.SourceName):
 						cell = autoEvent.SourceName
 					default:
@@ -241,18 +266,21 @@ func (deviceWriter *devicesXlsxWriter) convertAutoEvents() errors.EdgeX {
 // getNestedMapValue get the value of map from the passed MappingTable Path in the worksheet
 // e.g., modbus-rtu.Address returns the nested 'Address' value from device protocol properties map
 func getNestedMapValue(fieldNames []string, topLevelMap map[string]any) (any, errors.EdgeX) {
-	var innerValue any
-	fieldNameLength := len(fieldNames)
-
-	for i := 0; i < fieldNameLength; i++ {
-		if childLevelMap, ok := topLevelMap[fieldNames[i]].(map[string]any); ok {
+	for i, fieldName := range fieldNames {
+		value, ok := topLevelMap[fieldName]
+		if !ok || i == len(fieldNames)-1 {
+			// the map doesn't define the path if any level is missing
+			return value, nil
+		}
+		switch childLevelMap := value.(type) {
+		case map[string]any:
 			topLevelMap = childLevelMap
-		} else {
-			if i != fieldNameLength-1 {
-				return innerValue, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to get the inner value from map based on the MappingTable path %s", strings.Join(fieldNames, ".")), nil)
-			}
-			innerValue = topLevelMap[fieldNames[i]]
+		case dtos.ProtocolProperties:
+			// the nested protocol properties converted from the Devices sheet are ProtocolProperties
+			topLevelMap = childLevelMap
+		default:
+			return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to get the inner value from map based on the MappingTable path %s", strings.Join(fieldNames, ".")), nil)
 		}
 	}
-	return innerValue, nil
+	return nil, nil
 }
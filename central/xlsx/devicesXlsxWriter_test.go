@@ -78,7 +78,7 @@ func Test_DevicesToXlsxCloseFile(t *testing.T) {
 	xlsxWriter, err := newXlsxWriter(mockDevices, buffer)
 	require.NoError(t, err)
 
-	err = xlsxWriter.closeXlsxFile()
+	err = xlsxWriter.Close()
 	require.NoError(t, err)
 }
 
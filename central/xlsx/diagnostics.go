@@ -0,0 +1,227 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package xlsx
+
+import (
+	goerrors "errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// CellDiagnostic locates an error found while converting the xlsx file to DTOs. Row and Column locate the cell of the
+// error, the Row is 0 if the error is about a whole column, and the Column is empty if the error is about a whole row.
+type CellDiagnostic struct {
+	Sheet  string
+	Row    int
+	Column string
+	// Header is the header of the cell
+	Header string
+	// Reason is the error message
+	Reason string
+	// FieldError is the validation error of the DTO field converted from the cell, or nil if the cell failed to parse
+	FieldError *common.FieldError
+}
+
+// Cell returns the cell name of the diagnostic, e.g. B3, or the row number or column letter if the diagnostic is about
+// a whole row or column
+func (d CellDiagnostic) Cell() string {
+	switch {
+	case d.Row == 0:
+		return d.Column
+	case d.Column == "":
+		return fmt.Sprintf("%d", d.Row)
+	}
+	return fmt.Sprintf("%s%d", d.Column, d.Row)
+}
+
+func (d CellDiagnostic) Error() string {
+	if d.Header == "" {
+		return fmt.Sprintf("%s!%s: %s", d.Sheet, d.Cell(), d.Reason)
+	}
+	return fmt.Sprintf("%s!%s (%s): %s", d.Sheet, d.Cell(), d.Header, d.Reason)
+}
+
+// cellError is the error of parsing the cell at the index of a row, or a column of the sheets read by column
+type cellError struct {
+	index  int
+	header string
+	err    error
+}
+
+func (e *cellError) Error() string {
+	return e.err.Error()
+}
+
+func (e *cellError) Unwrap() error {
+	return e.err
+}
+
+// newCellError returns the error of parsing the cell at the index under the header
+func newCellError(index int, header string, message string, err error) errors.EdgeX {
+	return errors.NewCommonEdgeX(errors.KindContractInvalid, message, &cellError{index: index, header: header, err: err})
+}
+
+// sheetRecord is a row of the sheet converted to a DTO, or a column if the sheet is read by column, where index is the
+// zero-based index of the row or column
+type sheetRecord struct {
+	sheet         string
+	header        []string
+	fieldMappings map[string]mappingField // fieldMappings resolves the headers renamed in the MappingTable sheet
+	index         int
+	byColumn      bool
+}
+
+// diagnostic returns the diagnostic of the cell at the index of the record, or of the whole record if the index is -1
+func (r sheetRecord) diagnostic(index int, header string, reason string) CellDiagnostic {
+	d := CellDiagnostic{Sheet: r.sheet, Header: header, Reason: reason}
+	if r.byColumn {
+		d.Column, _ = excelize.ColumnNumberToName(r.index + 1)
+		d.Row = index + 1
+	} else {
+		d.Row = r.index + 1
+		if index >= 0 {
+			d.Column, _ = excelize.ColumnNumberToName(index + 1)
+		}
+	}
+	return d
+}
+
+// parseDiagnostic returns the diagnostic of the error returned by readStruct
+func (r sheetRecord) parseDiagnostic(err error) CellDiagnostic {
+	var cellErr *cellError
+	if goerrors.As(err, &cellErr) {
+		return r.diagnostic(cellErr.index, cellErr.header, cellErr.Error())
+	}
+	return r.diagnostic(-1, "", err.Error())
+}
+
+// validateDiagnostics returns the diagnostics of the validation error of the DTO converted from the record, each
+// invalid field is reported on the cell under the header of the field name
+func (r sheetRecord) validateDiagnostics(dto any, err error) []CellDiagnostic {
+	var diagnostics []CellDiagnostic
+	for _, fieldError := range common.ValidateFields(dto) {
+		index := r.headerIndex(fieldError.Field)
+		header := ""
+		if index >= 0 {
+			header = strings.TrimSpace(r.header[index])
+		}
+		d := r.diagnostic(index, header, fieldError.Message)
+		d.FieldError = &fieldError
+		diagnostics = append(diagnostics, d)
+	}
+	if len(diagnostics) == 0 {
+		// the error is not about a single field, e.g. a duplicated device resource
+		diagnostics = append(diagnostics, r.diagnostic(-1, "", err.Error()))
+	}
+	return diagnostics
+}
+
+// protocolDiagnostics returns the diagnostics of the protocol properties which failed the validation of the protocol
+// schemas, each invalid property is reported on the cell under the header mapped to its protocols path
+func (r sheetRecord) protocolDiagnostics(deviceProtocols map[string]dtos.ProtocolProperties, err error) []CellDiagnostic {
+	var diagnostics []CellDiagnostic
+	for _, protocol := range slices.Sorted(maps.Keys(deviceProtocols)) {
+		schema, ok := common.LookupProtocolSchema(protocol)
+		if !ok {
+			continue
+		}
+		for _, property := range schema.Properties {
+			value := deviceProtocols[protocol][property.Name]
+			propertyErr := schema.ValidateProperty(property.Name, value)
+			if propertyErr == nil {
+				continue
+			}
+			index := r.headerIndex(strings.Join([]string{strings.ToLower(protocols), protocol, property.Name}, MappingPathSeparator))
+			header := ""
+			if index >= 0 {
+				header = strings.TrimSpace(r.header[index])
+			}
+			d := r.diagnostic(index, header, propertyErr.Message())
+			d.FieldError = &common.FieldError{
+				Namespace: fmt.Sprintf("Device.%s.%s.%s", protocols, protocol, property.Name),
+				Field:     property.Name,
+				Value:     value,
+				Message:   propertyErr.Message(),
+			}
+			diagnostics = append(diagnostics, d)
+		}
+	}
+	if len(diagnostics) == 0 {
+		diagnostics = append(diagnostics, r.diagnostic(-1, "", err.Error()))
+	}
+	return diagnostics
+}
+
+// headerIndex returns the index of the header of the field, which is either named after the field or mapped to the
+// field path in the MappingTable sheet, e.g. the Address header mapped to protocols.modbus-tcp.Address. The headers
+// mapped to the protocols.*.<property> paths only match if no header is mapped to the path of the protocol.
+func (r sheetRecord) headerIndex(field string) int {
+	wildcardIndex := -1
+	for i, header := range r.header {
+		header = strings.TrimSpace(header)
+		if header == field {
+			return i
+		}
+		mapping, ok := r.fieldMappings[header]
+		if !ok {
+			continue
+		}
+		switch matchMappingPath(mapping.path, field) {
+		case mappingPathMatched:
+			return i
+		case mappingPathWildcardMatched:
+			if wildcardIndex < 0 {
+				wildcardIndex = i
+			}
+		}
+	}
+	return wildcardIndex
+}
+
+type mappingPathMatch int
+
+const (
+	mappingPathUnmatched mappingPathMatch = iota
+	mappingPathMatched
+	mappingPathWildcardMatched
+)
+
+// matchMappingPath checks if the path defined in the MappingTable sheet is the field path, where the MappingPathWildcard
+// matches any protocol of the protocols paths
+func matchMappingPath(mappingPath string, field string) mappingPathMatch {
+	mappingPaths := strings.Split(mappingPath, MappingPathSeparator)
+	fieldPaths := strings.Split(field, MappingPathSeparator)
+	if len(mappingPaths) != len(fieldPaths) {
+		return mappingPathUnmatched
+	}
+	match := mappingPathMatched
+	for i, path := range mappingPaths {
+		path = strings.TrimSpace(path)
+		switch {
+		case i == 0 && strings.EqualFold(path, fieldPaths[i]):
+		case path == fieldPaths[i]:
+		case i == 1 && path == MappingPathWildcard && strings.EqualFold(mappingPaths[0], protocols):
+			match = mappingPathWildcardMatched
+		default:
+			return mappingPathUnmatched
+		}
+	}
+	return match
+}
+
+// GetValidateDiagnostics returns the diagnostics of the cells which failed to parse or validate while converting the
+// xlsx file to DTOs
+func (b *baseXlsx) GetValidateDiagnostics() []CellDiagnostic {
+	return b.diagnostics
+}
@@ -0,0 +1,151 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package xlsx
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_deviceXlsx_GetValidateDiagnostics(t *testing.T) {
+	deviceX, err := createDeviceXlsxInst()
+	require.NoError(t, err)
+	xlsFile := deviceX.(*deviceXlsx).xlsFile
+	defer xlsFile.Close()
+
+	invalidRow := slices.Clone(validDeviceRow)
+	invalidRow[0] = ""
+	invalidRow[5] = "BROKEN"
+	unnamedRow := slices.Clone(validDeviceRow)
+	unnamedRow[0] = ""
+	for i, row := range [][]any{validDeviceHeader, validDeviceRow, invalidRow, unnamedRow} {
+		require.NoError(t, xlsFile.SetSheetRow(devicesSheetName, fmt.Sprintf("A%d", i+1), &row))
+	}
+
+	require.NoError(t, deviceX.ConvertToDTO())
+	require.Len(t, deviceX.GetDTOs(), 1)
+	require.Len(t, deviceX.GetValidateErrors(), 1, "the rows without name share the same validate error key")
+
+	diagnostics := deviceX.(DiagnosticsConverter).GetValidateDiagnostics()
+	require.Len(t, diagnostics, 3)
+	cells := make([]string, len(diagnostics))
+	for i, d := range diagnostics {
+		assert.Equal(t, devicesSheetName, d.Sheet)
+		require.NotNil(t, d.FieldError)
+		cells[i] = d.Cell()
+	}
+	assert.ElementsMatch(t, []string{"A3", "F3", "A4"}, cells)
+
+	idx := slices.IndexFunc(diagnostics, func(d CellDiagnostic) bool { return d.Cell() == "F3" })
+	assert.Equal(t, "AdminState", diagnostics[idx].Header)
+	assert.Equal(t, "oneof", diagnostics[idx].FieldError.Tag)
+	assert.Equal(t, "Devices!F3 (AdminState): "+diagnostics[idx].FieldError.Message, diagnostics[idx].Error())
+}
+
+func Test_deviceXlsx_GetValidateDiagnostics_MappedHeaders(t *testing.T) {
+	deviceX, err := createDeviceXlsxInst()
+	require.NoError(t, err)
+	xlsFile := deviceX.(*deviceXlsx).xlsFile
+	defer xlsFile.Close()
+
+	// the DataBits and Address headers are mapped to protocols.modbus-rtu.DataBits and protocols.modbus-rtu.Address
+	invalidRow := slices.Clone(validDeviceRow)
+	invalidRow[6] = ""
+	invalidRow[8] = 4
+	for i, row := range [][]any{validDeviceHeader, validDeviceRow, invalidRow} {
+		require.NoError(t, xlsFile.SetSheetRow(devicesSheetName, fmt.Sprintf("A%d", i+1), &row))
+	}
+
+	require.NoError(t, deviceX.ConvertToDTO())
+	require.Len(t, deviceX.GetDTOs(), 1)
+
+	diagnostics := deviceX.(DiagnosticsConverter).GetValidateDiagnostics()
+	require.Len(t, diagnostics, 2)
+	assert.Equal(t, "G3", diagnostics[0].Cell())
+	assert.Equal(t, common.ModbusAddress, diagnostics[0].Header)
+	assert.Equal(t, "I3", diagnostics[1].Cell())
+	assert.Equal(t, common.ModbusDataBits, diagnostics[1].Header)
+	for _, d := range diagnostics {
+		require.NotNil(t, d.FieldError)
+		assert.Equal(t, d.Header, d.FieldError.Field)
+		assert.Equal(t, "Device.Protocols.modbus-rtu."+d.Header, d.FieldError.Namespace)
+	}
+}
+
+func Test_sheetRecord_headerIndex(t *testing.T) {
+	record := sheetRecord{
+		header: []string{"Name", " Address ", "Port", "SlaveID"},
+		fieldMappings: map[string]mappingField{
+			"Address": {path: "protocols.*.Address"},
+			"Port":    {path: "protocols.modbus-tcp.Port"},
+			"SlaveID": {path: "protocols.modbus-tcp.Address"},
+		},
+	}
+	tests := []struct {
+		name          string
+		field         string
+		expectedIndex int
+	}{
+		{"field name", "Name", 0},
+		{"mapped path", "protocols.modbus-tcp.Port", 2},
+		{"mapped path preferred to wildcard", "protocols.modbus-tcp.Address", 3},
+		{"wildcard path", "protocols.modbus-rtu.Address", 1},
+		{"unmapped path", "protocols.modbus-rtu.Port", -1},
+		{"unknown field", "Labels", -1},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedIndex, record.headerIndex(testCase.field))
+		})
+	}
+}
+
+func Test_convertAutoEvents_ParseDiagnostic(t *testing.T) {
+	deviceX, err := createDeviceXlsxInst()
+	require.NoError(t, err)
+	xlsFile := deviceX.(*deviceXlsx).xlsFile
+	defer xlsFile.Close()
+
+	_, err = xlsFile.NewSheet(autoEventsSheetName)
+	require.NoError(t, err)
+	headerRow := []any{"Interval", "OnChange", "SourceName"}
+	require.NoError(t, xlsFile.SetSheetRow(autoEventsSheetName, "A1", &headerRow))
+	dataRow := []any{"1s", "notBool", "temperature"}
+	require.NoError(t, xlsFile.SetSheetRow(autoEventsSheetName, "A2", &dataRow))
+
+	require.Error(t, deviceX.(*deviceXlsx).convertAutoEvents())
+	diagnostics := deviceX.(DiagnosticsConverter).GetValidateDiagnostics()
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, autoEventsSheetName, diagnostics[0].Sheet)
+	assert.Equal(t, "B2", diagnostics[0].Cell())
+	assert.Equal(t, "OnChange", diagnostics[0].Header)
+	assert.Nil(t, diagnostics[0].FieldError)
+}
+
+func Test_sheetRecord_diagnostic(t *testing.T) {
+	tests := []struct {
+		name         string
+		record       sheetRecord
+		index        int
+		expectedCell string
+	}{
+		{"cell of row", sheetRecord{sheet: deviceResourceSheetName, index: 2}, 1, "B3"},
+		{"whole row", sheetRecord{sheet: deviceResourceSheetName, index: 2}, -1, "3"},
+		{"cell of column", sheetRecord{sheet: deviceCommandSheetName, index: 2, byColumn: true}, 1, "C2"},
+		{"whole column", sheetRecord{sheet: deviceCommandSheetName, index: 2, byColumn: true}, -1, "C"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedCell, tt.record.diagnostic(tt.index, "", "").Cell())
+		})
+	}
+}
@@ -0,0 +1,225 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package xlsx
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+)
+
+// diffIgnoredFields are the DTO fields assigned by core-metadata, which are not written to the xlsx file
+var diffIgnoredFields = []string{"Id", "DBTimestamp"}
+
+// Difference is a field difference between the original DTO and the DTO read back from the xlsx file
+type Difference struct {
+	// Path locates the field, e.g. Sensor01.Protocols.modbus-tcp.Port or DeviceResources[Temperature].Properties.Units
+	Path string
+	// Original is the value of the original DTO, or nil if the field is missing
+	Original any
+	// Reimported is the value of the DTO read back from the xlsx file, or nil if the field is missing
+	Reimported any
+}
+
+func (d Difference) String() string {
+	if d.Original != nil && d.Reimported != nil && reflect.TypeOf(d.Original) != reflect.TypeOf(d.Reimported) {
+		return fmt.Sprintf("%s: %v (%T) != %v (%T)", d.Path, d.Original, d.Original, d.Reimported, d.Reimported)
+	}
+	return fmt.Sprintf("%s: %v != %v", d.Path, d.Original, d.Reimported)
+}
+
+// DiffDevices returns the field differences between the original devices and the devices read back from the xlsx file,
+// the devices are matched by name. The numbers are compared by their text, e.g. the int 502 and the int64 502 are the
+// same, but a value read back with another type is a difference, e.g. the string "502" and the number 502. The empty
+// strings, maps and slices of the fields are the same as the missing ones, but an empty map value or slice element is
+// not the same as a missing one.
+func DiffDevices(original []dtos.Device, reimported []*dtos.Device) []Difference {
+	var diffs []Difference
+	reimportedByName := make(map[string]*dtos.Device, len(reimported))
+	for _, device := range reimported {
+		reimportedByName[device.Name] = device
+	}
+	for _, device := range original {
+		reimportedDevice, ok := reimportedByName[device.Name]
+		if !ok {
+			diffs = append(diffs, Difference{Path: device.Name, Original: device})
+			continue
+		}
+		delete(reimportedByName, device.Name)
+		diffValue(device.Name, reflect.ValueOf(device), reflect.ValueOf(*reimportedDevice), &diffs)
+	}
+	for _, device := range reimported {
+		if _, ok := reimportedByName[device.Name]; ok {
+			diffs = append(diffs, Difference{Path: device.Name, Reimported: *device})
+		}
+	}
+	return diffs
+}
+
+// DiffDeviceProfiles returns the field differences between the original profile and the profile read back from the
+// xlsx file, the resources and commands are matched by name. The values are compared like DiffDevices.
+func DiffDeviceProfiles(original dtos.DeviceProfile, reimported *dtos.DeviceProfile) []Difference {
+	if reimported == nil {
+		return []Difference{{Path: original.Name, Original: original}}
+	}
+	var diffs []Difference
+	diffValue(original.Name, reflect.ValueOf(original), reflect.ValueOf(*reimported), &diffs)
+	return diffs
+}
+
+func diffValue(path string, original, reimported reflect.Value, diffs *[]Difference) {
+	original, reimported = indirect(original), indirect(reimported)
+	if isEmptyValue(original) && isEmptyValue(reimported) && original.IsValid() == reimported.IsValid() {
+		return
+	}
+	if !original.IsValid() || !reimported.IsValid() || original.Kind() != reimported.Kind() && !isScalar(original, reimported) {
+		*diffs = append(*diffs, Difference{Path: path, Original: valueInterface(original), Reimported: valueInterface(reimported)})
+		return
+	}
+
+	switch original.Kind() {
+	case reflect.Struct:
+		for i := 0; i < original.NumField(); i++ {
+			field := original.Type().Field(i)
+			if !field.IsExported() || slices.Contains(diffIgnoredFields, field.Name) {
+				continue
+			}
+			fieldPath := path + MappingPathSeparator + field.Name
+			if field.Anonymous {
+				// the fields of the embedded struct, e.g. DeviceProfileBasicInfo, belong to the outer struct
+				fieldPath = path
+			}
+			diffValue(fieldPath, original.Field(i), reimported.FieldByName(field.Name), diffs)
+		}
+	case reflect.Map:
+		for _, key := range mapKeys(original, reimported) {
+			diffValue(path+MappingPathSeparator+key, mapIndex(original, key), mapIndex(reimported, key), diffs)
+		}
+	case reflect.Slice:
+		if names, ok := elementNames(original, reimported); ok {
+			for _, name := range names {
+				diffValue(fmt.Sprintf("%s[%s]", path, name), elementByName(original, name), elementByName(reimported, name), diffs)
+			}
+			return
+		}
+		for i := 0; i < max(original.Len(), reimported.Len()); i++ {
+			diffValue(fmt.Sprintf("%s[%d]", path, i), sliceIndex(original, i), sliceIndex(reimported, i), diffs)
+		}
+	default:
+		if scalarType(original) != scalarType(reimported) || fmt.Sprintf("%v", original.Interface()) != fmt.Sprintf("%v", reimported.Interface()) {
+			*diffs = append(*diffs, Difference{Path: path, Original: original.Interface(), Reimported: reimported.Interface()})
+		}
+	}
+}
+
+// indirect returns the value pointed to or held by the pointer or interface, or the invalid value if it is nil
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	if !v.IsValid() {
+		return true
+	}
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	}
+	return false
+}
+
+// isScalar checks if both values are neither structs, maps nor slices, e.g. the int 502 and the string "502"
+func isScalar(values ...reflect.Value) bool {
+	for _, v := range values {
+		switch v.Kind() {
+		case reflect.Struct, reflect.Map, reflect.Slice:
+			return false
+		}
+	}
+	return true
+}
+
+// scalarType returns the type of the scalar value as the xlsx cell reads it back, i.e. the integers and floats are the
+// same numbers whatever their size
+func scalarType(v reflect.Value) reflect.Kind {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return reflect.Float64
+	}
+	return v.Kind()
+}
+
+func valueInterface(v reflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+	return v.Interface()
+}
+
+func mapKeys(maps ...reflect.Value) []string {
+	var keys []string
+	for _, m := range maps {
+		for _, key := range m.MapKeys() {
+			if !slices.Contains(keys, key.String()) {
+				keys = append(keys, key.String())
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func mapIndex(m reflect.Value, key string) reflect.Value {
+	return m.MapIndex(reflect.ValueOf(key).Convert(m.Type().Key()))
+}
+
+func sliceIndex(s reflect.Value, i int) reflect.Value {
+	if i >= s.Len() {
+		return reflect.Value{}
+	}
+	return s.Index(i)
+}
+
+// elementNames returns the names of the struct elements of both slices in order, if the elements have a Name field,
+// e.g. the resources and commands of the profile
+func elementNames(values ...reflect.Value) ([]string, bool) {
+	var names []string
+	for _, s := range values {
+		if s.Type().Elem().Kind() != reflect.Struct {
+			return nil, false
+		}
+		if _, ok := s.Type().Elem().FieldByName("Name"); !ok {
+			return nil, false
+		}
+		for i := 0; i < s.Len(); i++ {
+			name := s.Index(i).FieldByName("Name").String()
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	return names, true
+}
+
+func elementByName(s reflect.Value, name string) reflect.Value {
+	for i := 0; i < s.Len(); i++ {
+		if s.Index(i).FieldByName("Name").String() == name {
+			return s.Index(i)
+		}
+	}
+	return reflect.Value{}
+}
@@ -0,0 +1,94 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package xlsx
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffDevices(t *testing.T) {
+	original := dtos.Device{
+		Id:        "0f0d3c64-5f3b-4d1e-8d4b-3c8f2b1a4e5d",
+		Name:      "Sensor01",
+		Labels:    []string{"a", "b"},
+		Protocols: map[string]dtos.ProtocolProperties{common.ModbusTcp: {common.ModbusAddress: "10.0.0.1", common.ModbusPort: 502}},
+		Tags:      map[string]any{"Zone": "north"},
+	}
+	same := original
+	same.Id = ""
+	same.Protocols = map[string]dtos.ProtocolProperties{common.ModbusTcp: {common.ModbusAddress: "10.0.0.1", common.ModbusPort: int64(502)}}
+	same.Properties = map[string]any{}
+
+	changed := original
+	changed.Labels = []string{"a"}
+	changed.Protocols = map[string]dtos.ProtocolProperties{common.ModbusTcp: {common.ModbusAddress: "10.0.0.2", common.ModbusPort: 502}}
+	changed.Tags = map[string]any{"Zone": "south", "Floor": 1}
+
+	typeChanged := original
+	typeChanged.Protocols = map[string]dtos.ProtocolProperties{common.ModbusTcp: {common.ModbusAddress: "10.0.0.1", common.ModbusPort: "502"}}
+	typeChanged.Tags = map[string]any{"Zone": true}
+
+	tests := []struct {
+		name       string
+		reimported []*dtos.Device
+		expected   []Difference
+	}{
+		{"same", []*dtos.Device{&same}, nil},
+		{"changed", []*dtos.Device{&changed}, []Difference{
+			{Path: "Sensor01.Labels[1]", Original: "b"},
+			{Path: "Sensor01.Protocols.modbus-tcp.Address", Original: "10.0.0.1", Reimported: "10.0.0.2"},
+			{Path: "Sensor01.Tags.Floor", Reimported: 1},
+			{Path: "Sensor01.Tags.Zone", Original: "north", Reimported: "south"},
+		}},
+		{"type changed", []*dtos.Device{&typeChanged}, []Difference{
+			{Path: "Sensor01.Protocols.modbus-tcp.Port", Original: 502, Reimported: "502"},
+			{Path: "Sensor01.Tags.Zone", Original: "north", Reimported: true},
+		}},
+		{"missing", nil, []Difference{{Path: "Sensor01", Original: original}}},
+		{"extra", []*dtos.Device{&same, {Name: "Sensor02"}}, []Difference{{Path: "Sensor02", Reimported: dtos.Device{Name: "Sensor02"}}}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, DiffDevices([]dtos.Device{original}, testCase.reimported))
+		})
+	}
+}
+
+func TestDiffDeviceProfiles(t *testing.T) {
+	minimum := 1.0
+	original := dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "profile"},
+		DeviceResources: []dtos.DeviceResource{
+			{Name: "r1", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeInt32, Minimum: &minimum}},
+			{Name: "r2", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeInt32}},
+		},
+		DeviceCommands: []dtos.DeviceCommand{
+			{Name: "c1", ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "r1", Mappings: map[string]string{"0": "off"}}}},
+		},
+	}
+	reimported := dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "profile", Model: "m1"},
+		DeviceResources: []dtos.DeviceResource{
+			{Name: "r2", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeInt32}},
+			{Name: "r1", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeInt32}},
+		},
+		DeviceCommands: []dtos.DeviceCommand{
+			{Name: "c1", ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "r1"}}},
+		},
+	}
+
+	require.Equal(t, []Difference{
+		{Path: "profile.Model", Original: "", Reimported: "m1"},
+		{Path: "profile.DeviceResources[r1].Properties.Minimum", Original: minimum},
+		{Path: "profile.DeviceCommands[c1].ResourceOperations[0].Mappings.0", Original: "off"},
+	}, DiffDeviceProfiles(original, &reimported))
+	require.Equal(t, []Difference{{Path: "profile", Original: original}}, DiffDeviceProfiles(original, nil))
+}
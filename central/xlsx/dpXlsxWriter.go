@@ -5,9 +5,11 @@
 package xlsx
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -41,13 +43,25 @@ func newXlsxWriter[T AllowedDTOConverterTypes](s T, xlsxReader io.Reader) (DTOCo
 			baseXlsx: baseXlsx{
 				xlsFile:       f,
 				fieldMappings: fieldMappings,
+				roundTrip:     isRoundTripXlsx(f),
 			},
 			devices: any(s).([]dtos.Device),
 		}, nil
 	case dtos.DeviceProfile:
+		// the MappingTable sheet is optional for the device profile, it maps the columns to the tags of resources and commands
+		var fieldMappings map[string]mappingField
+		if slices.Contains(f.GetSheetList(), mappingTableSheetName) {
+			var edgexErr errors.EdgeX
+			fieldMappings, edgexErr = convertMappingTable(f)
+			if edgexErr != nil {
+				return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+			}
+		}
 		return &dpXlsxWriter{
 			baseXlsx: baseXlsx{
-				xlsFile: f,
+				xlsFile:       f,
+				fieldMappings: fieldMappings,
+				roundTrip:     isRoundTripXlsx(f),
 			},
 			deviceProfile: any(s).(dtos.DeviceProfile),
 		}, nil
@@ -91,7 +105,7 @@ func (dpWriter *dpXlsxWriter) Write(w io.Writer) errors.EdgeX {
 	return nil
 }
 
-func (dpWriter *dpXlsxWriter) closeXlsxFile() errors.EdgeX {
+func (dpWriter *dpXlsxWriter) Close() errors.EdgeX {
 	err := dpWriter.xlsFile.Close()
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindServerError, "failed to close xlsx file", err)
@@ -113,10 +127,13 @@ func (dpWriter *dpXlsxWriter) convertDeviceInfo() errors.EdgeX {
 
 	profile := dpWriter.deviceProfile
 	for i, cell := range cols[0] {
-		var value string
+		var value any
 		switch strings.ToLower(cell) {
 		case strings.ToLower(apiVersion):
 			value = common.ApiVersion
+			if profile.ApiVersion != "" {
+				value = profile.ApiVersion
+			}
 		case common.Name:
 			value = profile.Name
 		case common.Manufacturer:
@@ -126,11 +143,15 @@ func (dpWriter *dpXlsxWriter) convertDeviceInfo() errors.EdgeX {
 		case strings.ToLower(description):
 			value = profile.Description
 		case common.Labels:
-			value = strings.Join(profile.Labels, ", ")
+			value = profile.Labels
 		default:
 			// unknown header
 			continue
 		}
+		value, edgexErr := dpWriter.cellValue(value, false)
+		if edgexErr != nil {
+			return errors.NewCommonEdgeXWrapper(edgexErr)
+		}
 		err = f.SetCellValue(deviceInfoSheetName, fmt.Sprintf("B%d", i+1), value)
 		if err != nil {
 			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to set cell value in the '%s' sheet", deviceInfoSheetName), err)
@@ -163,6 +184,8 @@ func (dpWriter *dpXlsxWriter) convertDeviceResources() errors.EdgeX {
 			}
 
 			var cell any
+			// isMapValue is set if the cell is a value of the Tags or Attributes map
+			isMapValue := false
 			field := v.Elem().FieldByName(headerCell)
 			if field.Kind() != reflect.Invalid {
 				// header matches the DeviceResource field name (one of the Name, Description or IsHidden field name)
@@ -173,6 +196,8 @@ func (dpWriter *dpXlsxWriter) convertDeviceResources() errors.EdgeX {
 					cell = res.Description
 				case strings.ToLower(isHidden):
 					cell = strconv.FormatBool(res.IsHidden)
+				case strings.ToLower(tag):
+					cell = res.Tag
 				default:
 					continue
 				}
@@ -221,36 +246,51 @@ func (dpWriter *dpXlsxWriter) convertDeviceResources() errors.EdgeX {
 						cell = res.Properties.Assertion
 					case strings.ToLower(mediaType):
 						cell = res.Properties.MediaType
+					case strings.ToLower(optional):
+						if len(res.Properties.Optional) > 0 {
+							cell = res.Properties.Optional
+						}
 					default:
 						continue
 					}
+				} else if tagName, ok := tagsMappingName(dpWriter.fieldMappings, headerCell); ok {
+					// header is mapped to a tags path in the MappingTable
+					cell = res.Tags[tagName]
+					isMapValue = true
 				} else {
-					attrNames := strings.Split(headerCell, mappingPathSeparator)
+					attrNames := strings.Split(headerCell, MappingPathSeparator)
 					attrNameLength := len(attrNames)
-					if attrNameLength >= 1 {
-						if topLevelAttr, ok := res.Attributes[attrNames[0]]; ok {
-							if attrNameLength == 1 {
-								// header is only 1-level attribute name, e.g., primaryTable
-								cell = topLevelAttr
-							} else {
-								//handle the multi-level Attributes map, e.g., dataTypeId.identifier attribute in opc-ua
-								for i := 1; i < attrNameLength; i++ {
-									if topAttrMap, ok := topLevelAttr.(map[string]any); ok {
-										topLevelAttr = topAttrMap[attrNames[i]]
-									} else {
-										return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to convert device resource attribute into column '%s' from %s worksheet", headerCell, deviceResourceSheetName), err)
-									}
+					if topLevelAttr, ok := res.Attributes[attrNames[0]]; ok {
+						if attrNameLength == 1 {
+							// header is only 1-level attribute name, e.g., primaryTable
+							cell = topLevelAttr
+						} else {
+							//handle the multi-level Attributes map, e.g., dataTypeId.identifier attribute in opc-ua
+							for i := 1; i < attrNameLength; i++ {
+								if topAttrMap, ok := topLevelAttr.(map[string]any); ok {
+									topLevelAttr = topAttrMap[attrNames[i]]
+								} else {
+									return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to convert device resource attribute into column '%s' from %s worksheet", headerCell, deviceResourceSheetName), err)
 								}
-								cell = topLevelAttr
 							}
+							cell = topLevelAttr
 						}
+						isMapValue = true
 					} else if tag, ok := res.Tags[headerCell]; ok {
 						// header belongs to the Tags map field
 						cell = tag
+						isMapValue = true
 					}
 				}
 			}
 
+			if cell == nil {
+				continue
+			}
+			cell, edgexErr := dpWriter.cellValue(cell, isMapValue)
+			if edgexErr != nil {
+				return errors.NewCommonEdgeXWrapper(edgexErr)
+			}
 			if cell == "" {
 				continue
 			}
@@ -300,16 +340,24 @@ OUTER:
 				cell = cmd.IsHidden
 			case strings.ToLower(readWrite):
 				cell = cmd.ReadWrite
-			case strings.ToLower(common.ResourceName):
-			case strings.ToLower(resourceOperation):
+			case strings.ToLower(common.ResourceName), strings.ToLower(resourceOperation):
 				edgexErr := dpWriter.setResourceNameCells(colIndex, cmdIndex, cmd.ResourceOperations)
-				if err != nil {
+				if edgexErr != nil {
 					return errors.NewCommonEdgeXWrapper(edgexErr)
 				}
 				// ResourceName should be the last row in header column
 				continue OUTER
 			default:
-				continue
+				tagName, ok := tagsMappingName(dpWriter.fieldMappings, headerCell)
+				if !ok || cmd.Tags[tagName] == nil {
+					continue
+				}
+				// header is mapped to a tags path in the MappingTable
+				var edgexErr errors.EdgeX
+				cell, edgexErr = dpWriter.cellValue(cmd.Tags[tagName], true)
+				if edgexErr != nil {
+					return errors.NewCommonEdgeXWrapper(edgexErr)
+				}
 			}
 
 			// get the current column name of the cell will be set
@@ -336,7 +384,16 @@ func (dpWriter *dpXlsxWriter) setResourceNameCells(startRow int, colNumber int,
 
 	rowNum := startRow + 1
 	for i, op := range resOps {
-		err = dpWriter.xlsFile.SetCellValue(deviceCommandSheetName, fmt.Sprintf("%s%d", columnName, rowNum+i), op.DeviceResource)
+		cell := op.DeviceResource
+		if op.DefaultValue != "" || len(op.Mappings) > 0 {
+			// write the ResourceOperation in JSON to keep its DefaultValue and Mappings
+			jsonResOp, err := json.Marshal(op)
+			if err != nil {
+				return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to convert the ResourceOperation of '%s' to JSON", op.DeviceResource), err)
+			}
+			cell = string(jsonResOp)
+		}
+		err = dpWriter.xlsFile.SetCellValue(deviceCommandSheetName, fmt.Sprintf("%s%d", columnName, rowNum+i), cell)
 		if err != nil {
 			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to set cell value '%s' to ResourceName header in the '%s' sheet", op.DeviceResource, deviceCommandSheetName), err)
 		}
@@ -77,7 +77,7 @@ func Test_DPToXlsxCloseFile(t *testing.T) {
 	xlsxWriter, err := newXlsxWriter(mockDeviceProfile, buffer)
 	require.NoError(t, err)
 
-	err = xlsxWriter.closeXlsxFile()
+	err = xlsxWriter.Close()
 	require.NoError(t, err)
 }
 
@@ -25,6 +25,13 @@ type Converter[T AllowedDTOTypes] interface {
 	GetValidateErrors() map[string]error
 }
 
+// DiagnosticsConverter is implemented by the Converters which report the cell of each error, the callers type-assert
+// a Converter to it
+type DiagnosticsConverter interface {
+	// GetValidateDiagnostics returns the sheet, cell and field of each error while parsing the excel data rows to DTOs
+	GetValidateDiagnostics() []CellDiagnostic
+}
+
 type AllowedDTOConverterTypes interface {
 	dtos.DeviceProfile | []dtos.Device
 }
@@ -34,6 +41,7 @@ type DTOConverter[T AllowedDTOConverterTypes] interface {
 	ConvertToXlsx() errors.EdgeX
 	// Write writes xlsx file content to io.Writer
 	Write(io.Writer) errors.EdgeX
-	// closeXlsxFile closes the xlsx file reader
-	closeXlsxFile() errors.EdgeX
+	// Close closes the xlsx file reader, it is exported so that the converters of other packages, e.g. the CSV writer,
+	// can implement DTOConverter
+	Close() errors.EdgeX
 }
@@ -6,8 +6,10 @@
 package xlsx
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -16,7 +18,9 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 )
 
-func readStruct(structPtr any, headerCol []string, row []string, mapppingTable map[string]mappingField) (any, errors.EdgeX) {
+// readStruct converts the xlsx row to the DTO of the structPtr, the cells are read as written in the round-trip mode if
+// roundTrip is set
+func readStruct(structPtr any, headerCol []string, row []string, mapppingTable map[string]mappingField, roundTrip bool) (any, errors.EdgeX) {
 	var extraReturnedCols any
 	v := reflect.ValueOf(structPtr)
 	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
@@ -29,15 +33,15 @@ func readStruct(structPtr any, headerCol []string, row []string, mapppingTable m
 	var err errors.EdgeX
 	switch elementType {
 	case reflect.TypeOf(dtos.DeviceProfile{}):
-		err = convertDTOStdTypeFields(&rowElement, row, headerCol, mapppingTable)
+		err = convertDTOStdTypeFields(&rowElement, row, headerCol, mapppingTable, roundTrip)
 	case reflect.TypeOf(dtos.AutoEvent{}):
-		extraReturnedCols, err = convertAutoEventFields(&rowElement, row, headerCol, mapppingTable)
+		extraReturnedCols, err = convertAutoEventFields(&rowElement, row, headerCol, mapppingTable, roundTrip)
 	case reflect.TypeOf(dtos.Device{}):
-		err = convertDeviceFields(&rowElement, row, headerCol, mapppingTable)
+		err = convertDeviceFields(&rowElement, row, headerCol, mapppingTable, roundTrip)
 	case reflect.TypeOf(dtos.DeviceCommand{}):
-		err = convertDeviceCommandFields(&rowElement, row, headerCol)
+		err = convertDeviceCommandFields(&rowElement, row, headerCol, mapppingTable, roundTrip)
 	case reflect.TypeOf(dtos.DeviceResource{}):
-		err = convertResourcesFields(&rowElement, row, headerCol, mapppingTable)
+		err = convertResourcesFields(&rowElement, row, headerCol, mapppingTable, roundTrip)
 	default:
 		// skip the processing of the not found field name
 		err = errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("unknown converted DTO type '%T'", elementType), nil)
@@ -71,6 +75,10 @@ func setStdStructFieldValue(originValue string, field reflect.Value) errors.Edge
 	case reflect.String:
 		fieldValue = originValue
 	case reflect.Slice:
+		// the slice fields, e.g. Labels, are nil if the cell is empty
+		if originValue == "" {
+			return nil
+		}
 		values := strings.Split(originValue, common.CommaSeparator)
 		fieldValue = values
 	case reflect.Bool:
@@ -85,6 +93,46 @@ func setStdStructFieldValue(originValue string, field reflect.Value) errors.Edge
 			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse originValue '%v' to Int64 type", originValue), err)
 		}
 		fieldValue = int64Value
+	case reflect.Uint64:
+		uint64Value, err := strconv.ParseUint(originValue, 10, 64)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse originValue '%v' to Uint64 type", originValue), err)
+		}
+		fieldValue = uint64Value
+	case reflect.Float64:
+		// the float fields, e.g. the OnChangeThreshold of AutoEvent, are zero if the cell is empty
+		if originValue == "" {
+			return nil
+		}
+		float64Value, err := strconv.ParseFloat(originValue, 64)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse originValue '%v' to Float64 type", originValue), err)
+		}
+		fieldValue = float64Value
+	case reflect.Ptr:
+		// the optional number fields, e.g. the Minimum or Scale of ResourceProperties, are nil if the cell is empty
+		if originValue == "" {
+			return nil
+		}
+		elem := reflect.New(field.Type().Elem())
+		err := setStdStructFieldValue(originValue, elem.Elem())
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		field.Set(elem)
+		return nil
+	case reflect.Map:
+		// the map fields, e.g. the Optional of ResourceProperties, are written in JSON
+		if originValue == "" {
+			return nil
+		}
+		mapValue := reflect.New(field.Type())
+		err := json.Unmarshal([]byte(originValue), mapValue.Interface())
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse originValue '%v' to %s type", originValue, field.Type()), err)
+		}
+		field.Set(mapValue.Elem())
+		return nil
 	case reflect.Interface:
 		fieldValue = originValue
 	default:
@@ -96,7 +144,7 @@ func setStdStructFieldValue(originValue string, field reflect.Value) errors.Edge
 }
 
 // convertDTOStdTypeFields unmarshalls the xlsx cells into the standard type fields of the DTO struct
-func convertDTOStdTypeFields(rowElement *reflect.Value, xlsxRow []string, headerCol []string, fieldMappings map[string]mappingField) errors.EdgeX {
+func convertDTOStdTypeFields(rowElement *reflect.Value, xlsxRow []string, headerCol []string, fieldMappings map[string]mappingField, roundTrip bool) errors.EdgeX {
 	for colIndex, cell := range xlsxRow {
 		headerName, field := getStructFieldByHeader(rowElement, colIndex, headerCol)
 		fieldValue := strings.TrimSpace(cell)
@@ -109,9 +157,9 @@ func convertDTOStdTypeFields(rowElement *reflect.Value, xlsxRow []string, header
 				}
 			}
 
-			err := setStdStructFieldValue(fieldValue, field)
+			err := setCellFieldValue(fieldValue, field, roundTrip)
 			if err != nil {
-				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("error occurred on '%s' column", headerName), err)
+				return newCellError(colIndex, headerName, fmt.Sprintf("error occurred on '%s' column", headerName), err)
 			}
 		} else {
 			// field not found in the DTO struct, skip this column
@@ -122,7 +170,7 @@ func convertDTOStdTypeFields(rowElement *reflect.Value, xlsxRow []string, header
 }
 
 // convertDeviceFields convert the xlsx row to the Device DTO
-func convertDeviceFields(rowElement *reflect.Value, xlsxRow []string, headerCol []string, fieldMappings map[string]mappingField) errors.EdgeX {
+func convertDeviceFields(rowElement *reflect.Value, xlsxRow []string, headerCol []string, fieldMappings map[string]mappingField, roundTrip bool) errors.EdgeX {
 	if fieldMappings == nil {
 		return errors.NewCommonEdgeX(errors.KindServerError, "fieldMappings not defined while converting device fields", nil)
 	}
@@ -130,22 +178,25 @@ func convertDeviceFields(rowElement *reflect.Value, xlsxRow []string, headerCol
 	prtPropMap := make(map[string]dtos.ProtocolProperties)
 	propertiesMap := make(map[string]any)
 	tagsMap := make(map[string]any)
+	rowProtocol := readRowProtocol(xlsxRow, headerCol, fieldMappings)
 
 	for colIndex, cell := range xlsxRow {
 		headerName, field := getStructFieldByHeader(rowElement, colIndex, headerCol)
 		fieldValue := strings.TrimSpace(cell)
+		isDefaultValue := false
 		if fieldValue == "" {
 			// set fieldValue to 'default value' defined in mapping Table if not empty
 			if mapping, ok := fieldMappings[headerName]; ok && mapping.defaultValue != "" {
 				fieldValue = mapping.defaultValue
+				isDefaultValue = true
 			}
 		}
 
 		if field.Kind() != reflect.Invalid {
 			// header matches the Device DTO field name (one of the Name, Description, AdminState, OperatingState, etc)
-			err := setStdStructFieldValue(fieldValue, field)
+			err := setCellFieldValue(fieldValue, field, roundTrip)
 			if err != nil {
-				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("error occurred on '%s' column", headerName), err)
+				return newCellError(colIndex, headerName, fmt.Sprintf("error occurred on '%s' column", headerName), err)
 			}
 		} else {
 			// header not belongs to the above fields with standard types
@@ -153,7 +204,7 @@ func convertDeviceFields(rowElement *reflect.Value, xlsxRow []string, headerCol
 			if fieldValue != "" {
 				// get the Path defined in the MappingTable
 				if mapping, ok := fieldMappings[headerName]; ok && mapping.path != "" {
-					splitPaths := strings.SplitN(mapping.path, mappingPathSeparator, 2)
+					splitPaths := strings.SplitN(mapping.path, MappingPathSeparator, 2)
 					fieldPrefix := strings.TrimSpace(splitPaths[0])
 
 					fieldName := headerName
@@ -161,7 +212,7 @@ func convertDeviceFields(rowElement *reflect.Value, xlsxRow []string, headerCol
 						fieldName = strings.TrimSpace(splitPaths[1])
 					}
 
-					convertedValue := parseStringToActualType(fieldValue)
+					convertedValue := parseCellValue(fieldValue, roundTrip)
 
 					switch fieldPrefix {
 					case strings.ToLower(protocols):
@@ -177,8 +228,19 @@ func convertDeviceFields(rowElement *reflect.Value, xlsxRow []string, headerCol
 
 						// to handle the nested ProtocolProperties name
 						// split the ProtocolProperties name using the "." separator into array
-						prtPropNames := strings.Split(fieldName, mappingPathSeparator)
+						prtPropNames := strings.Split(fieldName, MappingPathSeparator)
 						lastPropNameIdx := len(prtPropNames) - 1
+						if prtPropNames[0] == MappingPathWildcard {
+							if rowProtocol == "" {
+								return newCellError(colIndex, headerName, fmt.Sprintf("error occurred on '%s' column", headerName),
+									errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s is required by the '%s' path", protocolName, mapping.path), nil))
+							}
+							prtPropNames[0] = rowProtocol
+						} else if isDefaultValue && isOtherProtocol(rowProtocol, prtPropNames[0]) {
+							// the default value of another protocol doesn't apply to the device, e.g. the default
+							// BACnet-IP Port doesn't apply to a modbus-tcp device of the same sheet
+							continue
+						}
 
 						var innerPrtProp dtos.ProtocolProperties
 						for i, propName := range prtPropNames {
@@ -211,7 +273,7 @@ func convertDeviceFields(rowElement *reflect.Value, xlsxRow []string, headerCol
 						propertiesMap[fieldName] = convertedValue
 					case strings.ToLower(tags):
 						// set the cell to Tags map
-						tagsMap[headerName] = convertedValue
+						tagsMap[fieldName] = convertedValue
 					default:
 						// unknown column header
 						continue
@@ -239,8 +301,32 @@ func convertDeviceFields(rowElement *reflect.Value, xlsxRow []string, headerCol
 	return nil
 }
 
+// readRowProtocol returns the ProtocolName cell of the Devices row, or the default value of the ProtocolName object
+// defined in the MappingTable sheet if the cell is empty
+func readRowProtocol(xlsxRow []string, headerCol []string, fieldMappings map[string]mappingField) string {
+	colIndex := slices.IndexFunc(headerCol, func(header string) bool { return strings.TrimSpace(header) == protocolName })
+	if colIndex != -1 && colIndex < len(xlsxRow) {
+		if rowProtocol := strings.TrimSpace(xlsxRow[colIndex]); rowProtocol != "" {
+			return rowProtocol
+		}
+	}
+	return fieldMappings[protocolName].defaultValue
+}
+
+// isOtherProtocol checks if the protocol of a protocols path is a known protocol other than the protocol of the row.
+// Protocols without a schema are never treated as other protocols, as a protocol name like ethernet-ip spans the
+// O2T, T2O and Key protocol properties.
+func isOtherProtocol(rowProtocol, pathProtocol string) bool {
+	if rowProtocol == "" || rowProtocol == pathProtocol {
+		return false
+	}
+	_, rowOk := common.LookupProtocolSchema(rowProtocol)
+	_, pathOk := common.LookupProtocolSchema(pathProtocol)
+	return rowOk && pathOk
+}
+
 // convertAutoEventFields convert the xlsx row to the AutoEvent DTO
-func convertAutoEventFields(rowElement *reflect.Value, xlsxRow []string, headerCol []string, fieldMappings map[string]mappingField) ([]string, errors.EdgeX) {
+func convertAutoEventFields(rowElement *reflect.Value, xlsxRow []string, headerCol []string, fieldMappings map[string]mappingField, roundTrip bool) ([]string, errors.EdgeX) {
 	if fieldMappings == nil {
 		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "fieldMappings not defined while converting AutoEvent fields", nil)
 	}
@@ -258,9 +344,9 @@ func convertAutoEventFields(rowElement *reflect.Value, xlsxRow []string, headerC
 
 		if field.Kind() != reflect.Invalid {
 			// header matches the AutoEvent DTO field name (one of the Interval, OnChange, SourceName field)
-			err := setStdStructFieldValue(fieldValue, field)
+			err := setCellFieldValue(fieldValue, field, roundTrip)
 			if err != nil {
-				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("error occurred on '%s' column", headerName), err)
+				return nil, newCellError(colIndex, headerName, fmt.Sprintf("error occurred on '%s' column", headerName), err)
 			}
 		} else {
 			// the cell belongs to the "Reference Device Name" column, append it to deviceNames
@@ -274,8 +360,9 @@ func convertAutoEventFields(rowElement *reflect.Value, xlsxRow []string, headerC
 }
 
 // convertDeviceCommandFields convert the xlsx row to the DeviceCommand DTO
-func convertDeviceCommandFields(rowElement *reflect.Value, xlsxCol []string, headerCol []string) errors.EdgeX {
+func convertDeviceCommandFields(rowElement *reflect.Value, xlsxCol []string, headerCol []string, fieldMappings map[string]mappingField, roundTrip bool) errors.EdgeX {
 	var resOpSlice []dtos.ResourceOperation
+	tagsMap := make(map[string]any)
 	for colIndex, cell := range xlsxCol {
 		// skip the empty cell, all the cell should have value in DeviceCommand sheet
 		if cell == "" {
@@ -287,19 +374,31 @@ func convertDeviceCommandFields(rowElement *reflect.Value, xlsxCol []string, hea
 
 		if field.Kind() != reflect.Invalid {
 			// header matches the DeviceCommand field name (one of the Name, IsHidden or ReadWrite field name)
-			err := setStdStructFieldValue(cell, field)
+			err := setCellFieldValue(cell, field, roundTrip)
 			if err != nil {
-				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("error occurred on '%s' row", headerName), err)
+				return newCellError(colIndex, headerName, fmt.Sprintf("error occurred on '%s' row", headerName), err)
 			}
+		} else if tagName, ok := tagsMappingName(fieldMappings, headerName); ok {
+			// set the cell to Tags map if the header is mapped to a tags path in the MappingTable
+			tagsMap[tagName] = parseCellValue(cell, roundTrip)
 		} else {
 			// parse the rest ResourceName columns in the xlsx row and convert to the ResourceOperation DTO
-			resOp := dtos.ResourceOperation{
-				DeviceResource: cell,
+			resOp, err := parseResourceOperation(cell)
+			if err != nil {
+				return newCellError(colIndex, headerName, fmt.Sprintf("error occurred on '%s' row", headerName), err)
 			}
 			resOpSlice = append(resOpSlice, resOp)
 		}
 	}
 
+	// set Tags field to the DeviceCommand DTO struct
+	if len(tagsMap) > 0 {
+		err := setMapToStructField(rowElement, tags, tagsMap)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
 	if len(resOpSlice) > 0 {
 		// set resOpSlice to the ResourceOperations field of DeviceCommand struct
 		resOpField := rowElement.FieldByName(resourceOperations)
@@ -311,11 +410,40 @@ func convertDeviceCommandFields(rowElement *reflect.Value, xlsxCol []string, hea
 	return nil
 }
 
+// parseResourceOperation parses a ResourceName cell of the DeviceCommand sheet, which is either the resource name or
+// the ResourceOperation in JSON if it has a DefaultValue or Mappings
+func parseResourceOperation(cell string) (dtos.ResourceOperation, errors.EdgeX) {
+	if !strings.HasPrefix(cell, "{") {
+		return dtos.ResourceOperation{DeviceResource: cell}, nil
+	}
+	var resOp dtos.ResourceOperation
+	err := json.Unmarshal([]byte(cell), &resOp)
+	if err != nil {
+		return resOp, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse '%s' to ResourceOperation", cell), err)
+	}
+	return resOp, nil
+}
+
+// tagsMappingName returns the tag name of the header if the header is mapped to a tags path in the MappingTable,
+// e.g. the header mapped to tags.Location returns Location
+func tagsMappingName(fieldMappings map[string]mappingField, headerName string) (string, bool) {
+	mapping, ok := fieldMappings[headerName]
+	if !ok {
+		return "", false
+	}
+	splitPaths := strings.SplitN(mapping.path, MappingPathSeparator, 2)
+	if len(splitPaths) < 2 || !strings.EqualFold(strings.TrimSpace(splitPaths[0]), tags) {
+		return "", false
+	}
+	return strings.TrimSpace(splitPaths[1]), true
+}
+
 // convertResourcesFields convert the xlsx row to the DeviceResource DTO
-func convertResourcesFields(rowElement *reflect.Value, xlsxRow []string, headerCol []string, fieldMappings map[string]mappingField) errors.EdgeX {
+func convertResourcesFields(rowElement *reflect.Value, xlsxRow []string, headerCol []string, fieldMappings map[string]mappingField, roundTrip bool) errors.EdgeX {
 	if fieldMappings == nil {
 		return errors.NewCommonEdgeX(errors.KindServerError, "fieldMappings not defined while converting DeviceResource fields", nil)
 	}
+	tagsMap := make(map[string]any)
 
 	for colIndex, cell := range xlsxRow {
 		headerName, field := getStructFieldByHeader(rowElement, colIndex, headerCol)
@@ -329,19 +457,27 @@ func convertResourcesFields(rowElement *reflect.Value, xlsxRow []string, headerC
 
 		if field.Kind() != reflect.Invalid {
 			// header matches the DeviceResource field name (one of the Name, Description or IsHidden field name)
-			err := setStdStructFieldValue(fieldValue, field)
+			err := setCellFieldValue(fieldValue, field, roundTrip)
 			if err != nil {
-				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("error occurred on '%s' column", headerName), err)
+				return newCellError(colIndex, headerName, fmt.Sprintf("error occurred on '%s' column", headerName), err)
 			}
 		} else {
 			resPropField := rowElement.FieldByName(properties).FieldByName(headerName)
 			if resPropField.Kind() != reflect.Invalid {
 				// header matches the ResourceProperties DTO field name (one of the ValueType, ReadWrite, Units, etc)
-				err := setStdStructFieldValue(fieldValue, resPropField)
+				err := setCellFieldValue(fieldValue, resPropField, roundTrip)
 				if err != nil {
-					return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("error occurred on '%s' column", headerName), err)
+					return newCellError(colIndex, headerName, fmt.Sprintf("error occurred on '%s' column", headerName), err)
 				}
 			} else {
+				// set the cell to Tags map if the header is mapped to a tags path in the MappingTable
+				if tagName, ok := tagsMappingName(fieldMappings, headerName); ok {
+					if fieldValue != "" {
+						tagsMap[tagName] = parseCellValue(fieldValue, roundTrip)
+					}
+					continue
+				}
+
 				// set the cell to Attributes map if header not belongs to Properties field
 				if fieldValue != "" {
 					// check if the header defined in the mapping table first, and if the path contains "attributes"
@@ -362,10 +498,10 @@ func convertResourcesFields(rowElement *reflect.Value, xlsxRow []string, headerC
 					}
 
 					// parse the attribute value to the actual data type other than string if needed
-					attrValue := parseStringToActualType(fieldValue)
+					attrValue := parseCellValue(fieldValue, roundTrip)
 
 					// to handle the nested attribute name, split the attribute name using the "." separator into array
-					attrNames := strings.Split(headerName, mappingPathSeparator)
+					attrNames := strings.Split(headerName, MappingPathSeparator)
 					attrNameLength := len(attrNames)
 					currentAttrMap := attrMap
 
@@ -394,5 +530,13 @@ func convertResourcesFields(rowElement *reflect.Value, xlsxRow []string, headerC
 		}
 	}
 
+	// set Tags field to the DeviceResource DTO struct
+	if len(tagsMap) > 0 {
+		err := setMapToStructField(rowElement, tags, tagsMap)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
 	return nil
 }
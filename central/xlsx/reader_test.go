@@ -38,9 +38,9 @@ func Test_readStruct(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var err error
 			if tt.structPtr != nil {
-				_, err = readStruct(tt.structPtr, tt.headerRow, tt.dataRow, validMappings)
+				_, err = readStruct(tt.structPtr, tt.headerRow, tt.dataRow, validMappings, false)
 			} else {
-				_, err = readStruct(&testStr, tt.headerRow, tt.dataRow, validMappings)
+				_, err = readStruct(&testStr, tt.headerRow, tt.dataRow, validMappings, false)
 			}
 			if tt.expectError {
 				require.Error(t, err, "Expected readStruct parse error not occurred")
@@ -118,7 +118,7 @@ func Test_convertDeviceFields(t *testing.T) {
 			elementType := v.Elem().Type()
 			element := reflect.New(elementType).Elem()
 
-			err := convertDeviceFields(&element, tt.dataRow, tt.headerCol, tt.fieldMappings)
+			err := convertDeviceFields(&element, tt.dataRow, tt.headerCol, tt.fieldMappings, false)
 			if tt.expectError {
 				require.Error(t, err, "Expected convertDeviceFields error not occurred")
 			} else {
@@ -159,7 +159,7 @@ func Test_convertAutoEventFields(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			deviceNames, err := convertAutoEventFields(tt.rowElement, tt.dataRow, tt.headerCol, tt.fieldMappings)
+			deviceNames, err := convertAutoEventFields(tt.rowElement, tt.dataRow, tt.headerCol, tt.fieldMappings, false)
 			if tt.expectError {
 				require.Error(t, err, "Expected convertAutoEventFields error not occurred")
 			} else {
@@ -189,7 +189,7 @@ func Test_convertDeviceCommandFields(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := convertDeviceCommandFields(tt.rowElement, tt.dataRow, tt.headerCol)
+			err := convertDeviceCommandFields(tt.rowElement, tt.dataRow, tt.headerCol, nil, false)
 			if tt.expectError {
 				require.Error(t, err, "Expected convertAutoEventFields error not occurred")
 			} else {
@@ -225,7 +225,7 @@ func Test_convertResourcesFields(t *testing.T) {
 			elementType := v.Elem().Type()
 			element := reflect.New(elementType).Elem()
 
-			err := convertResourcesFields(&element, tt.dataRow, tt.headerCol, tt.fieldMappings)
+			err := convertResourcesFields(&element, tt.dataRow, tt.headerCol, tt.fieldMappings, false)
 			v.Elem().Set(element)
 			if tt.expectError {
 				require.Error(t, err, "Expected convertResourcesFields error not occurred")
@@ -256,14 +256,14 @@ func Test_convertResourcesFields_Nested_Attributes(t *testing.T) {
 	elementType := v.Elem().Type()
 	element := reflect.New(elementType).Elem()
 
-	err = convertResourcesFields(&element, dataRow, headerCol, fieldMappings)
+	err = convertResourcesFields(&element, dataRow, headerCol, fieldMappings, false)
 	require.NoError(t, err)
 	v.Elem().Set(element)
 
 	require.Equal(t, dataRow[0], structPtr.Name)
 
 	// check the converted nested attributes int64 value
-	splitAttrNames := strings.Split(nestedAttrName1, mappingPathSeparator)
+	splitAttrNames := strings.Split(nestedAttrName1, MappingPathSeparator)
 	if innerAttr, ok := structPtr.Attributes[splitAttrNames[0]].(map[string]any); ok {
 		if attrVal, innerOk := innerAttr[splitAttrNames[1]].(int64); innerOk {
 			require.Equal(t, dataRow[1], strconv.FormatInt(attrVal, 10))
@@ -271,7 +271,7 @@ func Test_convertResourcesFields_Nested_Attributes(t *testing.T) {
 	}
 
 	// check the converted nested attributes string value
-	splitAttrNames = strings.Split(nestedAttrName2, mappingPathSeparator)
+	splitAttrNames = strings.Split(nestedAttrName2, MappingPathSeparator)
 	if innerAttr, ok := structPtr.Attributes[splitAttrNames[0]].(map[string]any); ok {
 		if attrVal, innerOk := innerAttr[splitAttrNames[1]]; innerOk {
 			require.Equal(t, dataRow[2], attrVal)
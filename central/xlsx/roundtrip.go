@@ -0,0 +1,399 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package xlsx
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// deviceRoundTripHeaders are the Device DTO fields written to the Devices sheet in the round-trip mode
+var deviceRoundTripHeaders = []string{"Name", description, "ServiceName", "ProfileName", "Labels", adminState, operatingState, parent}
+
+// autoEventRoundTripHeaders are the AutoEvent DTO fields written to the AutoEvents sheet in the round-trip mode
+var autoEventRoundTripHeaders = []string{"Interval", onChange, onChangeThreshold, "SourceName", refDeviceName}
+
+// deviceInfoRoundTripHeaders are the DeviceProfile DTO fields written to the DeviceInfo sheet in the round-trip mode
+var deviceInfoRoundTripHeaders = []string{"Name", "Manufacturer", "Model", description, "Labels"}
+
+// deviceCommandRoundTripHeaders are the DeviceCommand DTO fields written to the DeviceCommand sheet in the round-trip
+// mode, the ResourceName header is the last one as the resource names are written below it
+var deviceCommandRoundTripHeaders = []string{"Name", isHidden, readWrite}
+
+// roundTripDefinedName is the defined name which marks the xlsx written in the round-trip mode
+const roundTripDefinedName = "EdgeXRoundTrip"
+
+// roundTripConverter is the DTOConverter which extends the template so that every field of the DTOs has a column
+type roundTripConverter interface {
+	// addRoundTripColumns adds the missing columns and MappingTable rows of the DTO fields to the template
+	addRoundTripColumns() errors.EdgeX
+}
+
+// addRoundTripColumns adds the Devices columns of the Device fields, protocol properties, properties and tags, and the
+// AutoEvents columns, which are not defined in the template yet
+func (deviceWriter *devicesXlsxWriter) addRoundTripColumns() errors.EdgeX {
+	edgexErr := deviceWriter.markRoundTrip()
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
+	headers := slices.Clone(deviceRoundTripHeaders)
+	for _, device := range deviceWriter.devices {
+		protocol := deviceWriter.deviceProtocol(device)
+		var paths []string
+		for name, protocolProperties := range device.Protocols {
+			for _, path := range flattenMapPaths(protocolProperties) {
+				paths = append(paths, strings.Join([]string{strings.ToLower(protocols), name, path}, MappingPathSeparator))
+			}
+		}
+		for name := range device.Properties {
+			paths = append(paths, strings.ToLower(properties)+MappingPathSeparator+name)
+		}
+		for name := range device.Tags {
+			paths = append(paths, strings.ToLower(tags)+MappingPathSeparator+name)
+		}
+		sort.Strings(paths)
+
+		for _, path := range paths {
+			object, edgexErr := deviceWriter.mappingObject(path, protocol)
+			if edgexErr != nil {
+				return errors.NewCommonEdgeXWrapper(edgexErr)
+			}
+			if !slices.Contains(headers, object) {
+				headers = append(headers, object)
+			}
+		}
+	}
+
+	edgexErr = addHeaderRowCells(deviceWriter.xlsFile, devicesSheetName, headers)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	edgexErr = addHeaderRowCells(deviceWriter.xlsFile, autoEventsSheetName, autoEventRoundTripHeaders)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	return nil
+}
+
+// mappingObject returns the MappingTable object of the path, including the protocols.*.<property> paths of the device
+// protocol, and adds the path as a new object if it is not mapped yet
+func (deviceWriter *devicesXlsxWriter) mappingObject(path, protocol string) (string, errors.EdgeX) {
+	objects := make([]string, 0, len(deviceWriter.fieldMappings))
+	for object := range deviceWriter.fieldMappings {
+		objects = append(objects, object)
+	}
+	sort.Strings(objects)
+
+	for _, object := range objects {
+		mappingPath := strings.Split(deviceWriter.fieldMappings[object].path, MappingPathSeparator)
+		if len(mappingPath) > 2 && strings.EqualFold(mappingPath[0], protocols) && mappingPath[1] == MappingPathWildcard {
+			mappingPath[1] = protocol
+		}
+		if strings.Join(mappingPath, MappingPathSeparator) == path {
+			return object, nil
+		}
+	}
+
+	edgexErr := addMappingRow(deviceWriter.xlsFile, deviceWriter.fieldMappings, path)
+	if edgexErr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	return path, nil
+}
+
+// addRoundTripColumns adds the DeviceInfo, DeviceResource and DeviceCommand headers of the profile fields, resource
+// attributes and tags, which are not defined in the template yet
+func (dpWriter *dpXlsxWriter) addRoundTripColumns() errors.EdgeX {
+	if dpWriter.fieldMappings == nil {
+		dpWriter.fieldMappings = make(map[string]mappingField)
+	}
+	profile := dpWriter.deviceProfile
+
+	edgexErr := dpWriter.markRoundTrip()
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	edgexErr = addHeaderColumnCells(dpWriter.xlsFile, deviceInfoSheetName, deviceInfoRoundTripHeaders, "")
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
+	resourceHeaders := []string{"Name", description, isHidden}
+	var attributeHeaders, tagHeaders []string
+	for _, res := range profile.DeviceResources {
+		if res.Tag != "" && !slices.Contains(resourceHeaders, tag) {
+			resourceHeaders = append(resourceHeaders, tag)
+		}
+		// the non-zero ResourceProperties fields, e.g. ValueType, Minimum or Optional
+		resProps := reflect.ValueOf(res.Properties)
+		for i := 0; i < resProps.NumField(); i++ {
+			name := resProps.Type().Field(i).Name
+			if !resProps.Field(i).IsZero() && !slices.Contains(resourceHeaders, name) {
+				resourceHeaders = append(resourceHeaders, name)
+			}
+		}
+		for _, path := range flattenMapPaths(res.Attributes) {
+			if !slices.Contains(attributeHeaders, path) {
+				attributeHeaders = append(attributeHeaders, path)
+			}
+		}
+		tagHeaders, edgexErr = dpWriter.appendTagObjects(tagHeaders, res.Tags)
+		if edgexErr != nil {
+			return errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+	}
+	sort.Strings(attributeHeaders)
+	resourceHeaders = append(append(resourceHeaders, attributeHeaders...), tagHeaders...)
+	edgexErr = addHeaderRowCells(dpWriter.xlsFile, deviceResourceSheetName, resourceHeaders)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
+	if len(profile.DeviceCommands) == 0 {
+		return nil
+	}
+	commandHeaders := slices.Clone(deviceCommandRoundTripHeaders)
+	for _, cmd := range profile.DeviceCommands {
+		commandHeaders, edgexErr = dpWriter.appendTagObjects(commandHeaders, cmd.Tags)
+		if edgexErr != nil {
+			return errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+	}
+	edgexErr = addHeaderColumnCells(dpWriter.xlsFile, deviceCommandSheetName, commandHeaders, "ResourceName")
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	return nil
+}
+
+// appendTagObjects appends the MappingTable objects of the tags to the headers, the tags which are not mapped yet are
+// added to the MappingTable sheet
+func (dpWriter *dpXlsxWriter) appendTagObjects(headers []string, tagsMap map[string]any) ([]string, errors.EdgeX) {
+	names := make([]string, 0, len(tagsMap))
+	for name := range tagsMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+OUTER:
+	for _, name := range names {
+		for object := range dpWriter.fieldMappings {
+			if tagName, ok := tagsMappingName(dpWriter.fieldMappings, object); ok && tagName == name {
+				if !slices.Contains(headers, object) {
+					headers = append(headers, object)
+				}
+				continue OUTER
+			}
+		}
+		path := strings.ToLower(tags) + MappingPathSeparator + name
+		edgexErr := addMappingRow(dpWriter.xlsFile, dpWriter.fieldMappings, path)
+		if edgexErr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+		headers = append(headers, path)
+	}
+	return headers, nil
+}
+
+// markRoundTrip adds the defined name which marks the xlsx written in the round-trip mode, so that the cells are written
+// by toRoundTripCellValue and read back by parseRoundTripValue and setCellFieldValue
+func (b *baseXlsx) markRoundTrip() errors.EdgeX {
+	b.roundTrip = true
+	if isRoundTripXlsx(b.xlsFile) {
+		return nil
+	}
+	err := b.xlsFile.SetDefinedName(&excelize.DefinedName{Name: roundTripDefinedName, RefersTo: "TRUE"})
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to set the %s defined name", roundTripDefinedName), err)
+	}
+	return nil
+}
+
+// isRoundTripXlsx checks if the xlsx is written in the round-trip mode
+func isRoundTripXlsx(f *excelize.File) bool {
+	return slices.ContainsFunc(f.GetDefinedName(), func(definedName excelize.DefinedName) bool {
+		return definedName.Name == roundTripDefinedName
+	})
+}
+
+// cellValue returns the value written to a cell of a struct field, or of a map if isMapValue is set, by
+// toRoundTripCellValue if the xlsx is written in the round-trip mode, otherwise by toCellValue
+func (b *baseXlsx) cellValue(value any, isMapValue bool) (any, errors.EdgeX) {
+	if b.roundTrip {
+		return toRoundTripCellValue(value, isMapValue)
+	}
+	if labels, ok := value.([]string); ok && !isMapValue {
+		// the string slice fields, e.g. Labels, are written as comma-separated values
+		return strings.Join(labels, common.CommaSeparator), nil
+	}
+	return toCellValue(value)
+}
+
+// flattenMapPaths returns the sorted paths of the leaf values of the nested map, e.g. dataTypeId.identifier
+func flattenMapPaths(m map[string]any) []string {
+	var paths []string
+	for key, value := range m {
+		var inner map[string]any
+		switch innerMap := value.(type) {
+		case map[string]any:
+			inner = innerMap
+		case dtos.ProtocolProperties:
+			inner = innerMap
+		}
+		if len(inner) == 0 {
+			paths = append(paths, key)
+			continue
+		}
+		for _, innerPath := range flattenMapPaths(inner) {
+			paths = append(paths, key+MappingPathSeparator+innerPath)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// addMappingRow appends the path to the MappingTable sheet as an object of the same name without a default value
+func addMappingRow(f *excelize.File, fieldMappings map[string]mappingField, path string) errors.EdgeX {
+	if !slices.Contains(f.GetSheetList(), mappingTableSheetName) {
+		_, err := f.NewSheet(mappingTableSheetName)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to create %s worksheet", mappingTableSheetName), err)
+		}
+		err = f.SetSheetRow(mappingTableSheetName, "A1", &[]any{"Object", "Path", "Default Value"})
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to set the header row of %s worksheet", mappingTableSheetName), err)
+		}
+	}
+
+	rows, err := f.GetRows(mappingTableSheetName)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to retrieve all rows from %s worksheet", mappingTableSheetName), err)
+	}
+	objColIndex, pathColIndex := -1, -1
+	if len(rows) > 0 {
+		for colIndex, colCell := range rows[0] {
+			switch strings.ToLower(colCell) {
+			case objectCol:
+				objColIndex = colIndex
+			case pathCol:
+				pathColIndex = colIndex
+			}
+		}
+	}
+	if objColIndex == -1 || pathColIndex == -1 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("column Object or Path not defined in the header of %s worksheet", mappingTableSheetName), nil)
+	}
+
+	for colIndex, value := range map[int]string{objColIndex: path, pathColIndex: path} {
+		cell, err := excelize.CoordinatesToCellName(colIndex+1, len(rows)+1)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to convert column %d to cell name", colIndex+1), err)
+		}
+		err = f.SetCellValue(mappingTableSheetName, cell, value)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to set cell value in the '%s' sheet", mappingTableSheetName), err)
+		}
+	}
+	fieldMappings[path] = mappingField{path: path}
+	return nil
+}
+
+// addHeaderRowCells appends the headers missing from the header row of the sheet, the sheet is created if not exists
+func addHeaderRowCells(f *excelize.File, sheetName string, headers []string) errors.EdgeX {
+	header, edgexErr := readHeader(f, sheetName, false)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	for _, name := range headers {
+		if slices.Contains(header, name) {
+			continue
+		}
+		header = append(header, name)
+		cell, err := excelize.CoordinatesToCellName(len(header), 1)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to convert column %d to cell name", len(header)), err)
+		}
+		err = f.SetCellValue(sheetName, cell, name)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to set cell value in the '%s' sheet", sheetName), err)
+		}
+	}
+	return nil
+}
+
+// addHeaderColumnCells inserts the headers missing from the header column of the sheet before the lastHeader, which
+// is appended if missing and not empty, the sheet is created if not exists
+func addHeaderColumnCells(f *excelize.File, sheetName string, headers []string, lastHeader string) errors.EdgeX {
+	header, edgexErr := readHeader(f, sheetName, true)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	if lastHeader != "" && !slices.Contains(header, lastHeader) {
+		header = append(header, lastHeader)
+		err := f.SetCellValue(sheetName, fmt.Sprintf("A%d", len(header)), lastHeader)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to set cell value in the '%s' sheet", sheetName), err)
+		}
+	}
+
+	for _, name := range headers {
+		if slices.Contains(header, name) {
+			continue
+		}
+		rowNum := len(header) + 1
+		if lastHeader != "" {
+			// insert the header before the lastHeader
+			rowNum = slices.Index(header, lastHeader) + 1
+			err := f.InsertRows(sheetName, rowNum, 1)
+			if err != nil {
+				return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to insert row %d to the '%s' sheet", rowNum, sheetName), err)
+			}
+		}
+		header = slices.Insert(header, rowNum-1, name)
+		err := f.SetCellValue(sheetName, fmt.Sprintf("A%d", rowNum), name)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to set cell value in the '%s' sheet", sheetName), err)
+		}
+	}
+	return nil
+}
+
+// readHeader returns the header row or header column of the sheet, the sheet is created if not exists
+func readHeader(f *excelize.File, sheetName string, byColumn bool) ([]string, errors.EdgeX) {
+	if !slices.Contains(f.GetSheetList(), sheetName) {
+		_, err := f.NewSheet(sheetName)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to create %s worksheet", sheetName), err)
+		}
+		return nil, nil
+	}
+
+	var cells [][]string
+	var err error
+	if byColumn {
+		cells, err = f.GetCols(sheetName)
+	} else {
+		cells, err = f.GetRows(sheetName)
+	}
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to retrieve the header of %s worksheet", sheetName), err)
+	}
+	if len(cells) == 0 {
+		return nil, nil
+	}
+	return cells[0], nil
+}
@@ -0,0 +1,295 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package xlsx
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+// roundTripConfig runs the round-trip properties with a fixed seed so that a failure can be reproduced
+var roundTripConfig = &quick.Config{MaxCount: 30, Rand: rand.New(rand.NewSource(1))}
+
+// randomWord returns a word which is not read back as a number or boolean
+func randomWord(r *rand.Rand) string {
+	letters := []rune("abcdefghijklmnopqrstuvwxyz")
+	word := []rune{'w'}
+	for i := 0; i < 1+r.Intn(8); i++ {
+		word = append(word, letters[r.Intn(len(letters))])
+	}
+	return string(word)
+}
+
+// randomText returns a random word, or a string which looks like a number, boolean or JSON, e.g. "42", "0.5", "true" or
+// "[w]", has a comma or leading or trailing whitespace, or is empty, which must still be read back as the same string
+func randomText(r *rand.Rand) string {
+	switch r.Intn(8) {
+	case 0:
+		return fmt.Sprintf("%d", r.Intn(1000))
+	case 1:
+		return fmt.Sprintf("%d.%d", r.Intn(100), 1+r.Intn(9))
+	case 2:
+		return []string{"true", "false", "TRUE", "f"}[r.Intn(4)]
+	case 3:
+		return randomWord(r) + "," + randomWord(r)
+	case 4:
+		return []string{" ", "  ", "\t"}[r.Intn(3)] + randomWord(r) + []string{"", " "}[r.Intn(2)]
+	case 5:
+		return ""
+	case 6:
+		return fmt.Sprintf([]string{`"%s"`, `[%s]`, `{"%s":1}`}[r.Intn(3)], randomWord(r))
+	}
+	return randomWord(r)
+}
+
+func randomDevice(r *rand.Rand, index int) dtos.Device {
+	device := dtos.Device{
+		Name:           fmt.Sprintf("device-%d-%s", index, randomWord(r)),
+		AdminState:     []string{"LOCKED", "UNLOCKED"}[r.Intn(2)],
+		OperatingState: []string{"UP", "DOWN", "UNKNOWN"}[r.Intn(3)],
+		ServiceName:    randomWord(r),
+		ProfileName:    randomWord(r),
+		Protocols:      make(map[string]dtos.ProtocolProperties),
+		Tags:           map[string]any{},
+	}
+	if r.Intn(2) == 0 {
+		device.Description = randomWord(r) + " " + randomText(r)
+		device.Parent = randomWord(r)
+	}
+	for i := 0; i < r.Intn(3); i++ {
+		device.Labels = append(device.Labels, randomText(r))
+	}
+
+	protocol := common.ModbusTcp
+	if r.Intn(2) == 0 {
+		device.Protocols[protocol] = dtos.ProtocolProperties{
+			common.ModbusAddress: fmt.Sprintf("10.0.%d.%d", r.Intn(256), r.Intn(256)),
+			common.ModbusPort:    r.Intn(65536),
+			common.ModbusUnitID:  r.Intn(256),
+		}
+	} else {
+		// a protocol without schema, whose nested properties are kept as they are
+		protocol = "custom-" + randomWord(r)
+		device.Protocols[protocol] = dtos.ProtocolProperties{
+			randomWord(r): randomText(r),
+			"Settings":    map[string]any{"Mode": randomText(r), "Retries": r.Intn(10)},
+		}
+	}
+	device.Properties = map[string]any{common.ProtocolName: protocol, randomWord(r): r.Intn(1000), randomWord(r): randomText(r)}
+	for i := 0; i < r.Intn(3); i++ {
+		device.Tags[randomWord(r)] = randomText(r)
+	}
+	if r.Intn(2) == 0 {
+		device.Tags["Zones"] = []any{randomWord(r), randomText(r)}
+	}
+
+	for i := 0; i < r.Intn(3); i++ {
+		device.AutoEvents = append(device.AutoEvents, dtos.AutoEvent{
+			Interval:          fmt.Sprintf("%ds", 1+r.Intn(60)),
+			OnChange:          r.Intn(2) == 0,
+			OnChangeThreshold: float64(r.Intn(10)) / 4,
+			SourceName:        randomWord(r),
+		})
+	}
+	return device
+}
+
+func randomDeviceProfile(r *rand.Rand) dtos.DeviceProfile {
+	profile := dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{
+			Name:         "profile-" + randomWord(r),
+			Manufacturer: randomWord(r),
+			Model:        randomText(r),
+			Description:  randomWord(r) + " " + randomWord(r),
+			Labels:       []string{randomWord(r), randomText(r)},
+		},
+	}
+	for i := 0; i < 1+r.Intn(4); i++ {
+		resource := dtos.DeviceResource{
+			Name:     fmt.Sprintf("resource-%d", i),
+			IsHidden: r.Intn(2) == 0,
+			Properties: dtos.ResourceProperties{
+				ValueType: []string{common.ValueTypeInt32, common.ValueTypeFloat64, common.ValueTypeString}[r.Intn(3)],
+				ReadWrite: common.ReadWrite_RW,
+			},
+			Attributes: map[string]any{
+				"primaryTable":    randomText(r),
+				"startingAddress": r.Intn(1000),
+			},
+		}
+		if r.Intn(2) == 0 {
+			minimum, maximum, scale := float64(-r.Intn(100)), float64(r.Intn(100)), 0.5
+			mask, shift := uint64(r.Intn(256)), int64(r.Intn(8))
+			resource.Description = randomWord(r)
+			resource.Tag = randomText(r)
+			resource.Properties.Units = randomWord(r)
+			resource.Properties.Minimum, resource.Properties.Maximum, resource.Properties.Scale = &minimum, &maximum, &scale
+			resource.Properties.Mask, resource.Properties.Shift = &mask, &shift
+			resource.Properties.Optional = map[string]any{randomWord(r): randomText(r)}
+			resource.Attributes["dataTypeId"] = map[string]any{"identifier": r.Intn(100), "namespace": randomWord(r)}
+			resource.Tags = map[string]any{randomWord(r): randomText(r)}
+		}
+		profile.DeviceResources = append(profile.DeviceResources, resource)
+	}
+	for i := 0; i < r.Intn(3); i++ {
+		command := dtos.DeviceCommand{
+			Name:      fmt.Sprintf("command-%d", i),
+			IsHidden:  r.Intn(2) == 0,
+			ReadWrite: []string{common.ReadWrite_R, common.ReadWrite_W, common.ReadWrite_RW}[r.Intn(3)],
+		}
+		for _, resource := range profile.DeviceResources {
+			resOp := dtos.ResourceOperation{DeviceResource: resource.Name}
+			if r.Intn(2) == 0 {
+				resOp.DefaultValue = fmt.Sprintf("%d", r.Intn(100))
+				resOp.Mappings = map[string]string{"0": randomWord(r), "1": randomWord(r)}
+			}
+			command.ResourceOperations = append(command.ResourceOperations, resOp)
+		}
+		if r.Intn(2) == 0 {
+			command.Tags = map[string]any{randomWord(r): randomText(r)}
+		}
+		profile.DeviceCommands = append(profile.DeviceCommands, command)
+	}
+	return profile
+}
+
+// createRoundTripTemplate creates the minimal template of the sheets, the round-trip mode adds the other columns
+func createRoundTripTemplate(t *testing.T, headers map[string][]any) *bytes.Buffer {
+	f := excelize.NewFile()
+	defer f.Close()
+	for sheetName, header := range headers {
+		_, err := f.NewSheet(sheetName)
+		require.NoError(t, err)
+		require.NoError(t, f.SetSheetRow(sheetName, "A1", &header))
+	}
+	require.NoError(t, f.SetSheetRow(mappingTableSheetName, "A2", &[]any{isHidden, "isHidden", "false"}))
+	buffer, err := f.WriteToBuffer()
+	require.NoError(t, err)
+	return buffer
+}
+
+func TestConvertToRoundTripXlsx_Devices(t *testing.T) {
+	roundTrip := func(seed int64) bool {
+		r := rand.New(rand.NewSource(seed))
+		var devices []dtos.Device
+		for i := 0; i < 1+r.Intn(5); i++ {
+			devices = append(devices, randomDevice(r, i))
+		}
+
+		template := createRoundTripTemplate(t, map[string][]any{
+			devicesSheetName:      {"Name"},
+			mappingTableSheetName: {"Object", "Path", "Default Value"},
+			autoEventsSheetName:   {refDeviceName},
+		})
+		var output bytes.Buffer
+		require.NoError(t, ConvertToRoundTripXlsx(template, &output, devices))
+
+		deviceX, err := ConvertDeviceXlsx(&output)
+		require.NoError(t, err)
+		require.Empty(t, deviceX.GetValidateErrors())
+		diffs := DiffDevices(devices, deviceX.GetDTOs())
+		for _, diff := range diffs {
+			t.Logf("seed %d: %s", seed, diff)
+		}
+		return len(diffs) == 0
+	}
+	require.NoError(t, quick.Check(roundTrip, roundTripConfig))
+}
+
+func TestConvertToRoundTripXlsx_DeviceProfile(t *testing.T) {
+	roundTrip := func(seed int64) bool {
+		r := rand.New(rand.NewSource(seed))
+		profile := randomDeviceProfile(r)
+
+		template := createRoundTripTemplate(t, map[string][]any{
+			deviceInfoSheetName:     {"Name"},
+			deviceResourceSheetName: {"Name"},
+			mappingTableSheetName:   {"Object", "Path", "Default Value"},
+		})
+		var output bytes.Buffer
+		require.NoError(t, ConvertToRoundTripXlsx(template, &output, profile))
+
+		profileX, err := ConvertDeviceProfileXlsx(&output)
+		require.NoError(t, err)
+		require.Empty(t, profileX.GetValidateErrors())
+		diffs := DiffDeviceProfiles(profile, profileX.GetDTOs())
+		for _, diff := range diffs {
+			t.Logf("seed %d: %s", seed, diff)
+		}
+		return len(diffs) == 0
+	}
+	require.NoError(t, quick.Check(roundTrip, roundTripConfig))
+}
+
+func TestConvertToRoundTripXlsx_QuotedValues(t *testing.T) {
+	devices := []dtos.Device{{
+		Name:           "Sensor1",
+		Description:    " padded ",
+		AdminState:     "UNLOCKED",
+		OperatingState: "UP",
+		ServiceName:    "device-modbus",
+		ProfileName:    "tcp-profile",
+		Labels:         []string{"a,b", "c"},
+		Protocols:      map[string]dtos.ProtocolProperties{"custom": {"Address": "[1]"}},
+		Properties:     map[string]any{common.ProtocolName: "custom"},
+		Tags:           map[string]any{"Material": " lead", "Empty": "", "Mode": `{"Mode":"fast"}`},
+	}}
+	newTemplate := func() *bytes.Buffer {
+		return createRoundTripTemplate(t, map[string][]any{
+			devicesSheetName:      {"Name"},
+			mappingTableSheetName: {"Object", "Path", "Default Value"},
+			autoEventsSheetName:   {refDeviceName},
+		})
+	}
+
+	var output bytes.Buffer
+	require.NoError(t, ConvertToRoundTripXlsx(newTemplate(), &output, devices))
+	f, err := excelize.OpenReader(bytes.NewReader(output.Bytes()))
+	require.NoError(t, err)
+	require.True(t, isRoundTripXlsx(f))
+	deviceX, err := ConvertDeviceXlsx(&output)
+	require.NoError(t, err)
+	require.Empty(t, DiffDevices(devices, deviceX.GetDTOs()))
+
+	// the JSON cells of the xlsx not written in the round-trip mode are read as they are
+	template := excelize.NewFile()
+	defer template.Close()
+	sheetRows := map[string][][]any{
+		devicesSheetName: {
+			{"Name", adminState, operatingState, "ServiceName", "ProfileName", "Labels", "Address", "Mode"},
+			{"Sensor1", "UNLOCKED", "UP", "device-modbus", "tcp-profile", `["a,b"]`, "10.0.0.1", `{"Mode":"fast"}`},
+		},
+		mappingTableSheetName: {
+			{"Object", "Path", "Default Value"},
+			{"Address", "protocols.custom.Address"},
+			{"Mode", "tags.Mode"},
+		},
+	}
+	for sheetName, rows := range sheetRows {
+		_, err = template.NewSheet(sheetName)
+		require.NoError(t, err)
+		for i, row := range rows {
+			require.NoError(t, template.SetSheetRow(sheetName, fmt.Sprintf("A%d", i+1), &row))
+		}
+	}
+	buffer, err := template.WriteToBuffer()
+	require.NoError(t, err)
+	deviceX, err = ConvertDeviceXlsx(buffer)
+	require.NoError(t, err)
+	require.Empty(t, deviceX.GetValidateErrors())
+	require.Len(t, deviceX.GetDTOs(), 1)
+	require.Equal(t, []string{`["a`, `b"]`}, deviceX.GetDTOs()[0].Labels)
+	require.Equal(t, `{"Mode":"fast"}`, deviceX.GetDTOs()[0].Tags["Mode"])
+}
@@ -0,0 +1,244 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package xlsx
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	// defaultTemplateInterval is the Interval of the AutoEvents rows pre-filled by GenerateDeviceXlsxTemplate
+	defaultTemplateInterval = "10s"
+	// valueTypeValidationTag is the validation tag of the ValueType field, whose values are the EdgeX value types
+	valueTypeValidationTag = "edgex-dto-value-type"
+	oneOfValidationRule    = "oneof="
+)
+
+// templateDeviceHeaders are the Device DTO fields of the Devices sheet generated by GenerateDeviceXlsxTemplate, the
+// protocol properties are appended after them
+var templateDeviceHeaders = []string{"Name", description, "ServiceName", "ProfileName", protocolName, "Labels", adminState, operatingState}
+
+// templateResourceHeaders are the DeviceResource DTO fields listed in the DeviceResource sheet generated by
+// GenerateDeviceXlsxTemplate
+var templateResourceHeaders = []string{"Name", description, "ValueType", readWrite, units}
+
+// GenerateDeviceXlsxTemplate writes a blank Devices workbook for the devices of the profile and protocol, which can be
+// filled in and read by ConvertDeviceXlsx, or used as the template of ConvertToXlsx. The workbook contains:
+//   - the MappingTable sheet with the default AdminState, OperatingState, ProtocolName and ProfileName, and a
+//     protocols.<protocol>.<property> row of every property of the registered protocol schema
+//   - the Devices sheet with a column of every mapped object
+//   - the AutoEvents sheet with a row of every readable resource of the profile, the Reference Device Name is left
+//     for the user to fill in
+//   - the DeviceResource sheet listing the resources of the profile for reference
+//
+// The AdminState, OperatingState, ReadWrite and ValueType columns, and the protocol properties of enumerated values,
+// have drop-down lists of the values accepted by the DTO validation.
+func GenerateDeviceXlsxTemplate(profile dtos.DeviceProfile, protocol string, w io.Writer) errors.EdgeX {
+	if protocol == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "protocol is required to generate the Devices template", nil)
+	}
+
+	f := excelize.NewFile()
+	defer func() { _ = f.Close() }()
+	err := f.SetSheetName(f.GetSheetName(0), devicesSheetName)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to create %s worksheet", devicesSheetName), err)
+	}
+
+	edgexErr := generateDevicesSheets(f, profile, protocol)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	edgexErr = generateAutoEventsSheet(f, profile)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	edgexErr = generateResourcesSheet(f, profile)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
+	err = f.Write(w)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to write the Devices template", err)
+	}
+	return nil
+}
+
+// generateDevicesSheets writes the MappingTable rows and the Devices header of the profile and protocol
+func generateDevicesSheets(f *excelize.File, profile dtos.DeviceProfile, protocol string) errors.EdgeX {
+	mappingRows := [][]any{
+		{"Object", "Path", "Default Value"},
+		{adminState, "adminState", models.Unlocked},
+		{operatingState, "operatingState", models.Up},
+		{protocolName, "properties" + MappingPathSeparator + common.ProtocolName, protocol},
+		{"ProfileName", "profileName", profile.Name},
+	}
+	header := slices.Clone(templateDeviceHeaders)
+	dropLists := make(map[string][]string)
+	for _, fieldName := range []string{adminState, operatingState} {
+		enum, edgexErr := validateEnum(&dtos.Device{}, fieldName)
+		if edgexErr != nil {
+			return errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+		dropLists[fieldName] = enum
+	}
+
+	if schema, ok := common.LookupProtocolSchema(protocol); ok {
+		for _, property := range schema.Properties {
+			path := strings.Join([]string{"protocols", protocol, property.Name}, MappingPathSeparator)
+			var defaultValue string
+			if property.Default != nil {
+				defaultValue = fmt.Sprintf("%v", property.Default)
+			}
+			mappingRows = append(mappingRows, []any{property.Name, path, defaultValue})
+			header = append(header, property.Name)
+			if len(property.Enum) > 0 {
+				dropLists[property.Name] = property.Enum
+			}
+		}
+	}
+
+	_, err := f.NewSheet(mappingTableSheetName)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to create %s worksheet", mappingTableSheetName), err)
+	}
+	for rowIndex, row := range mappingRows {
+		err = f.SetSheetRow(mappingTableSheetName, fmt.Sprintf("A%d", rowIndex+1), &row)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to set the row %d of %s worksheet", rowIndex+1, mappingTableSheetName), err)
+		}
+	}
+
+	err = f.SetSheetRow(devicesSheetName, "A1", &header)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to set the header row of %s worksheet", devicesSheetName), err)
+	}
+	for colIndex, headerName := range header {
+		if enum, ok := dropLists[headerName]; ok {
+			edgexErr := addDropList(f, devicesSheetName, colIndex+1, enum)
+			if edgexErr != nil {
+				return errors.NewCommonEdgeXWrapper(edgexErr)
+			}
+		}
+	}
+	return nil
+}
+
+// generateAutoEventsSheet writes the AutoEvents rows of the readable resources of the profile
+func generateAutoEventsSheet(f *excelize.File, profile dtos.DeviceProfile) errors.EdgeX {
+	_, err := f.NewSheet(autoEventsSheetName)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to create %s worksheet", autoEventsSheetName), err)
+	}
+	err = f.SetSheetRow(autoEventsSheetName, "A1", &autoEventRoundTripHeaders)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to set the header row of %s worksheet", autoEventsSheetName), err)
+	}
+
+	rowIndex := 2
+	for _, resource := range profile.DeviceResources {
+		if !strings.Contains(resource.Properties.ReadWrite, common.ReadWrite_R) {
+			continue
+		}
+		// the row cells are in the order of autoEventRoundTripHeaders
+		row := []any{defaultTemplateInterval, false, nil, resource.Name}
+		err = f.SetSheetRow(autoEventsSheetName, fmt.Sprintf("A%d", rowIndex), &row)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to set the row %d of %s worksheet", rowIndex, autoEventsSheetName), err)
+		}
+		rowIndex++
+	}
+	return nil
+}
+
+// generateResourcesSheet writes the resources of the profile to the DeviceResource sheet
+func generateResourcesSheet(f *excelize.File, profile dtos.DeviceProfile) errors.EdgeX {
+	_, err := f.NewSheet(deviceResourceSheetName)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to create %s worksheet", deviceResourceSheetName), err)
+	}
+	err = f.SetSheetRow(deviceResourceSheetName, "A1", &templateResourceHeaders)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to set the header row of %s worksheet", deviceResourceSheetName), err)
+	}
+
+	for i, resource := range profile.DeviceResources {
+		// the row cells are in the order of templateResourceHeaders
+		row := []any{resource.Name, resource.Description, resource.Properties.ValueType, resource.Properties.ReadWrite, resource.Properties.Units}
+		err = f.SetSheetRow(deviceResourceSheetName, fmt.Sprintf("A%d", i+2), &row)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to set the row %d of %s worksheet", i+2, deviceResourceSheetName), err)
+		}
+	}
+
+	for colIndex, fieldName := range templateResourceHeaders {
+		if fieldName != "ValueType" && fieldName != readWrite {
+			continue
+		}
+		enum, edgexErr := validateEnum(&dtos.ResourceProperties{}, fieldName)
+		if edgexErr != nil {
+			return errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+		edgexErr = addDropList(f, deviceResourceSheetName, colIndex+1, enum)
+		if edgexErr != nil {
+			return errors.NewCommonEdgeXWrapper(edgexErr)
+		}
+	}
+	return nil
+}
+
+// addDropList adds the drop-down list of the values to the data cells of the column
+func addDropList(f *excelize.File, sheetName string, colNum int, values []string) errors.EdgeX {
+	colName, err := excelize.ColumnNumberToName(colNum)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to convert column number %d to name", colNum), err)
+	}
+	dv := excelize.NewDataValidation(true)
+	dv.SetSqref(fmt.Sprintf("%s2:%s%d", colName, colName, excelize.TotalRows))
+	err = dv.SetDropList(values)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to set the drop-down list of column %s in %s worksheet", colName, sheetName), err)
+	}
+	err = f.AddDataValidation(sheetName, dv)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to add the data validation of column %s in %s worksheet", colName, sheetName), err)
+	}
+	return nil
+}
+
+// validateEnum returns the values accepted by the validate tag of the struct field, i.e. the values of the oneof rule,
+// or the EdgeX value types of the edgex-dto-value-type rule
+func validateEnum(structPtr any, fieldName string) ([]string, errors.EdgeX) {
+	field, ok := reflect.TypeOf(structPtr).Elem().FieldByName(fieldName)
+	if !ok {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("field %s not found in %T", fieldName, structPtr), nil)
+	}
+	for _, rule := range strings.Split(field.Tag.Get("validate"), ",") {
+		switch {
+		case strings.HasPrefix(rule, oneOfValidationRule):
+			var values []string
+			for _, value := range strings.Fields(strings.TrimPrefix(rule, oneOfValidationRule)) {
+				values = append(values, strings.Trim(value, "'"))
+			}
+			return values, nil
+		case rule == valueTypeValidationTag:
+			return common.ValueTypes(), nil
+		}
+	}
+	return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("no enumerated values defined by the validate tag of %T field %s", structPtr, fieldName), nil)
+}
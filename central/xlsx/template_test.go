@@ -0,0 +1,139 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package xlsx
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+var templateProfile = dtos.DeviceProfile{
+	DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "thermostat"},
+	DeviceResources: []dtos.DeviceResource{
+		{Name: "Temperature", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_R, Units: "C"}},
+		{Name: "SetPoint", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_RW}},
+		{Name: "Reset", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeBool, ReadWrite: common.ReadWrite_W}},
+	},
+}
+
+func TestGenerateDeviceXlsxTemplate(t *testing.T) {
+	var buffer bytes.Buffer
+	require.NoError(t, GenerateDeviceXlsxTemplate(templateProfile, common.ModbusTcp, &buffer))
+
+	f, err := excelize.OpenReader(bytes.NewReader(buffer.Bytes()))
+	require.NoError(t, err)
+	defer f.Close()
+	assert.ElementsMatch(t, []string{devicesSheetName, mappingTableSheetName, autoEventsSheetName, deviceResourceSheetName}, f.GetSheetList())
+
+	mappingRows, err := f.GetRows(mappingTableSheetName)
+	require.NoError(t, err)
+	assert.Contains(t, mappingRows, []string{"ProfileName", "profileName", templateProfile.Name})
+	assert.Contains(t, mappingRows, []string{common.ModbusPort, "protocols.modbus-tcp.Port", "502"})
+
+	deviceRows, err := f.GetRows(devicesSheetName)
+	require.NoError(t, err)
+	require.Len(t, deviceRows, 1)
+	assert.Subset(t, deviceRows[0], []string{"Name", adminState, operatingState, protocolName, common.ModbusAddress, common.ModbusPort})
+
+	autoEventRows, err := f.GetRows(autoEventsSheetName)
+	require.NoError(t, err)
+	require.Len(t, autoEventRows, 3)
+	assert.Equal(t, "Temperature", autoEventRows[1][3])
+	assert.Equal(t, "SetPoint", autoEventRows[2][3])
+
+	deviceValidations, err := f.GetDataValidations(devicesSheetName)
+	require.NoError(t, err)
+	formulas := make(map[string]string)
+	for _, dv := range deviceValidations {
+		formulas[dv.Sqref] = dv.Formula1
+	}
+	assert.Equal(t, `"LOCKED,UNLOCKED"`, formulas["G2:G1048576"])
+	assert.Equal(t, `"UP,DOWN,UNKNOWN"`, formulas["H2:H1048576"])
+
+	resourceValidations, err := f.GetDataValidations(deviceResourceSheetName)
+	require.NoError(t, err)
+	require.Len(t, resourceValidations, 2)
+	assert.Contains(t, resourceValidations[0].Formula1, common.ValueTypeFloat32)
+	assert.Equal(t, `"R,W,RW,WR"`, resourceValidations[1].Formula1)
+}
+
+func TestGenerateDeviceXlsxTemplate_fillIn(t *testing.T) {
+	var buffer bytes.Buffer
+	require.NoError(t, GenerateDeviceXlsxTemplate(templateProfile, common.ModbusTcp, &buffer))
+
+	f, err := excelize.OpenReader(&buffer)
+	require.NoError(t, err)
+	defer f.Close()
+	header, err := f.GetRows(devicesSheetName)
+	require.NoError(t, err)
+	row := make([]any, len(header[0]))
+	for i, name := range header[0] {
+		switch name {
+		case "Name":
+			row[i] = "Thermostat01"
+		case "ServiceName":
+			row[i] = "device-modbus"
+		case common.ModbusAddress:
+			row[i] = "10.0.0.1"
+		}
+	}
+	require.NoError(t, f.SetSheetRow(devicesSheetName, "A2", &row))
+	require.NoError(t, f.SetCellValue(autoEventsSheetName, "E2", "Thermostat01"))
+	filled, err := f.WriteToBuffer()
+	require.NoError(t, err)
+
+	deviceX, err := ConvertDeviceXlsx(filled)
+	require.NoError(t, err)
+	require.Empty(t, deviceX.GetValidateErrors())
+	devices := deviceX.GetDTOs()
+	require.Len(t, devices, 1)
+	assert.Equal(t, "UNLOCKED", devices[0].AdminState)
+	assert.Equal(t, "UP", devices[0].OperatingState)
+	assert.Equal(t, templateProfile.Name, devices[0].ProfileName)
+	assert.Equal(t, common.ModbusTcp, devices[0].Properties[common.ProtocolName])
+	assert.EqualValues(t, 502, devices[0].Protocols[common.ModbusTcp][common.ModbusPort])
+	assert.Equal(t, []dtos.AutoEvent{{Interval: defaultTemplateInterval, SourceName: "Temperature"}}, devices[0].AutoEvents)
+}
+
+func TestGenerateDeviceXlsxTemplate_noProtocol(t *testing.T) {
+	var buffer bytes.Buffer
+	require.Error(t, GenerateDeviceXlsxTemplate(templateProfile, "", &buffer))
+}
+
+func Test_validateEnum(t *testing.T) {
+	tests := []struct {
+		name          string
+		structPtr     any
+		fieldName     string
+		expected      []string
+		errorExpected bool
+	}{
+		{"AdminState", &dtos.Device{}, adminState, []string{"LOCKED", "UNLOCKED"}, false},
+		{"OperatingState", &dtos.Device{}, operatingState, []string{"UP", "DOWN", "UNKNOWN"}, false},
+		{"ReadWrite", &dtos.ResourceProperties{}, readWrite, []string{"R", "W", "RW", "WR"}, false},
+		{"ValueType", &dtos.ResourceProperties{}, "ValueType", common.ValueTypes(), false},
+		{"no enum", &dtos.Device{}, "Name", nil, true},
+		{"no field", &dtos.Device{}, "Unknown", nil, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			values, err := validateEnum(testCase.structPtr, testCase.fieldName)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, values)
+		})
+	}
+}
@@ -18,6 +18,12 @@ type mappingField struct {
 	path         string // the path value defined in the MappingTable sheet
 }
 
+// NewDeviceXlsx creates the Converter of the Devices, MappingTable and AutoEvents sheets of the xlsx file without
+// converting them, so the validate diagnostics can still be read if ConvertToDTO fails
+func NewDeviceXlsx(file io.Reader) (Converter[[]*dtos.Device], errors.EdgeX) {
+	return newDeviceXlsx(file)
+}
+
 func ConvertDeviceXlsx(file io.Reader) (Converter[[]*dtos.Device], errors.EdgeX) {
 	deviceX, err := newDeviceXlsx(file)
 	if err != nil {
@@ -52,7 +58,42 @@ func ConvertToXlsx[T AllowedDTOConverterTypes](fileReader io.Reader, w io.Writer
 	if edgexErr != nil {
 		return edgexErr
 	}
-	defer func() { _ = xlsxWriter.closeXlsxFile() }()
+	defer func() { _ = xlsxWriter.Close() }()
+
+	edgexErr = xlsxWriter.ConvertToXlsx()
+	if edgexErr != nil {
+		return edgexErr
+	}
+
+	edgexErr = xlsxWriter.Write(w)
+	if edgexErr != nil {
+		return edgexErr
+	}
+	return nil
+}
+
+// ConvertToRoundTripXlsx converts the DTOs to the xlsx file like ConvertToXlsx and writes to io.Writer, but extends the
+// template with the columns and MappingTable rows of every DTO field which are not defined in the template, so that
+// ConvertDeviceXlsx or ConvertDeviceProfileXlsx reads back the same DTOs as reported by DiffDevices and
+// DiffDeviceProfiles. The xlsx is marked by a defined name, and the values which a cell would not read back as they are,
+// e.g. a label with a comma, a string with leading or trailing whitespace, or a tag value "502", are written in JSON,
+// which is only decoded when reading the xlsx marked so. The Id, timestamps and device Location are not written, and the
+// default values defined in the MappingTable still apply to the empty cells.
+func ConvertToRoundTripXlsx[T AllowedDTOConverterTypes](fileReader io.Reader, w io.Writer, convertData T) errors.EdgeX {
+	xlsxWriter, edgexErr := newXlsxWriter(convertData, fileReader)
+	if edgexErr != nil {
+		return edgexErr
+	}
+	defer func() { _ = xlsxWriter.Close() }()
+
+	roundTripWriter, ok := xlsxWriter.(roundTripConverter)
+	if !ok {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("xlsx writer of %T doesn't support the round-trip mode", convertData), nil)
+	}
+	edgexErr = roundTripWriter.addRoundTripColumns()
+	if edgexErr != nil {
+		return edgexErr
+	}
 
 	edgexErr = xlsxWriter.ConvertToXlsx()
 	if edgexErr != nil {
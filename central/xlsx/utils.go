@@ -6,11 +6,14 @@
 package xlsx
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"slices"
 	"strconv"
+	"strings"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	"github.com/xuri/excelize/v2"
@@ -107,3 +110,101 @@ func parseStringToActualType(strValue string) any {
 	}
 	return convertedValue
 }
+
+// parseRoundTripValue parses the map value of a cell written in the round-trip mode, the JSON object or array written
+// for the map or slice values is parsed to map[string]any or []any, and the JSON string written by toRoundTripCellValue
+// to string, the other values are parsed by parseStringToActualType
+func parseRoundTripValue(strValue string) any {
+	if strings.HasPrefix(strValue, `"`) {
+		var quotedValue string
+		if err := json.Unmarshal([]byte(strValue), &quotedValue); err == nil {
+			return quotedValue
+		}
+	}
+	if strings.HasPrefix(strValue, "{") || strings.HasPrefix(strValue, "[") {
+		var convertedValue any
+		if err := json.Unmarshal([]byte(strValue), &convertedValue); err == nil {
+			return convertedValue
+		}
+	}
+	return parseStringToActualType(strValue)
+}
+
+// parseCellValue parses the map value of a cell by parseRoundTripValue if the xlsx is written in the round-trip mode,
+// otherwise by parseStringToActualType
+func parseCellValue(strValue string, roundTrip bool) any {
+	if roundTrip {
+		return parseRoundTripValue(strValue)
+	}
+	return parseStringToActualType(strValue)
+}
+
+// setCellFieldValue sets the struct field to the cell value by setStdStructFieldValue, the JSON string or array which
+// toRoundTripCellValue writes for the string or slice fields, e.g. " lead" or ["a,b","c"], is decoded first if the
+// xlsx is written in the round-trip mode
+func setCellFieldValue(cell string, field reflect.Value, roundTrip bool) errors.EdgeX {
+	if roundTrip && (field.Kind() == reflect.String && strings.HasPrefix(cell, `"`) ||
+		field.Kind() == reflect.Slice && strings.HasPrefix(cell, "[")) {
+		value := reflect.New(field.Type())
+		if err := json.Unmarshal([]byte(cell), value.Interface()); err == nil {
+			field.Set(value.Elem())
+			return nil
+		}
+	}
+	return setStdStructFieldValue(cell, field)
+}
+
+// toCellValue returns the value written to a cell, the map and slice values are written in JSON
+func toCellValue(value any) (any, errors.EdgeX) {
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Map, reflect.Slice:
+		jsonValue, err := json.Marshal(value)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to convert %v to JSON", value), err)
+		}
+		return string(jsonValue), nil
+	}
+	return value, nil
+}
+
+// toRoundTripCellValue returns the value written to a cell in the round-trip mode like toCellValue, but the value which
+// would not be read back as the same value is written in JSON, i.e. the string of a struct field with leading or
+// trailing whitespace, the string slice of a struct field, e.g. the Labels, with a label which contains a comma, and
+// the string of a map which is empty or would be read back as another type, e.g. "502" or "true"
+func toRoundTripCellValue(value any, isMapValue bool) (any, errors.EdgeX) {
+	quoted := false
+	switch v := value.(type) {
+	case string:
+		if isMapValue {
+			parsedValue, ok := parseRoundTripValue(strings.TrimSpace(v)).(string)
+			quoted = v == "" || !ok || parsedValue != v
+		} else {
+			quoted = strings.TrimSpace(v) != v || strings.HasPrefix(v, `"`)
+		}
+	case []string:
+		if !isMapValue {
+			if readsBackAsLabels(v) {
+				return strings.Join(v, common.CommaSeparator), nil
+			}
+			quoted = true
+		}
+	}
+	if !quoted {
+		return toCellValue(value)
+	}
+
+	jsonValue, err := json.Marshal(value)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to convert %v to JSON", value), err)
+	}
+	return string(jsonValue), nil
+}
+
+// readsBackAsLabels checks if setCellFieldValue reads the comma-separated labels back as the same labels
+func readsBackAsLabels(labels []string) bool {
+	joined := strings.Join(labels, common.CommaSeparator)
+	if joined == "" || strings.TrimSpace(joined) != joined || strings.HasPrefix(joined, "[") {
+		return len(labels) == 0
+	}
+	return slices.Equal(strings.Split(joined, common.CommaSeparator), labels)
+}
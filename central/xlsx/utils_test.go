@@ -8,6 +8,7 @@ package xlsx
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
@@ -124,6 +125,8 @@ func Test_parseStringToActualType(t *testing.T) {
 		{"Parse string to float64", fmt.Sprintf("%f", mockFloatValue), mockFloatValue},
 		{"Parse string to int64", fmt.Sprintf("%d", mockIntValue), int64(mockIntValue)},
 		{"Parse boolean to int64", fmt.Sprintf("%t", mockBoolValue), mockBoolValue},
+		{"Keep JSON object as string", `{"Mode":"fast"}`, `{"Mode":"fast"}`},
+		{"Keep JSON string as string", `"6999"`, `"6999"`},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -132,3 +135,82 @@ func Test_parseStringToActualType(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseRoundTripValue(t *testing.T) {
+	tests := []struct {
+		name          string
+		originString  string
+		expectedValue any
+	}{
+		{"Parse string to int64", "6999", int64(6999)},
+		{"Parse JSON object to map", `{"Mode":"fast"}`, map[string]any{"Mode": "fast"}},
+		{"Parse JSON array to slice", `["a",1]`, []any{"a", float64(1)}},
+		{"Parse invalid JSON to string", "{Mode}", "{Mode}"},
+		{"Parse JSON string of number to string", `"6999"`, "6999"},
+		{"Parse JSON string with whitespace to string", `" lead"`, " lead"},
+		{"Parse invalid JSON string to string", `"6999`, `"6999`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := parseRoundTripValue(tt.originString)
+			require.Equal(t, tt.expectedValue, result)
+		})
+	}
+}
+
+func Test_toRoundTripCellValue(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         any
+		isMapValue    bool
+		expectedValue any
+	}{
+		{"word", "north", true, "north"},
+		{"number", 502, true, 502},
+		{"number string", "502", true, `"502"`},
+		{"boolean string", "true", true, `"true"`},
+		{"JSON string", `{"Mode":"fast"}`, true, `"{\"Mode\":\"fast\"}"`},
+		{"empty string", "", true, `""`},
+		{"whitespace string", " lead", true, `" lead"`},
+		{"map", map[string]any{"Mode": "fast"}, true, `{"Mode":"fast"}`},
+		{"field word", "north", false, "north"},
+		{"field number string", "502", false, "502"},
+		{"field whitespace string", "south ", false, `"south "`},
+		{"field quoted string", `"north"`, false, `"\"north\""`},
+		{"labels", []string{"a", "b"}, false, "a,b"},
+		{"labels with comma", []string{"a,b", "c"}, false, `["a,b","c"]`},
+		{"labels with whitespace", []string{" a", "b"}, false, `[" a","b"]`},
+		{"empty label", []string{""}, false, `[""]`},
+		{"no labels", []string{}, false, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := toRoundTripCellValue(tt.value, tt.isMapValue)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedValue, result)
+
+			cell, ok := result.(string)
+			if !ok {
+				return
+			}
+			cell = strings.TrimSpace(cell)
+			switch value := tt.value.(type) {
+			case string:
+				if tt.isMapValue {
+					require.Equal(t, value, parseRoundTripValue(cell))
+					return
+				}
+				var field string
+				require.NoError(t, setCellFieldValue(cell, reflect.ValueOf(&field).Elem(), true))
+				require.Equal(t, value, field)
+			case []string:
+				var labels []string
+				require.NoError(t, setCellFieldValue(cell, reflect.ValueOf(&labels).Elem(), true))
+				require.Equal(t, len(value), len(labels))
+				for i := range value {
+					require.Equal(t, value[i], labels[i])
+				}
+			}
+		})
+	}
+}
@@ -8,12 +8,14 @@ package http
 
 import (
 	"context"
+	"iter"
 	"net/url"
 	"strconv"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -26,10 +28,10 @@ type CommandClient struct {
 }
 
 // NewCommandClient creates an instance of CommandClient
-func NewCommandClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool) interfaces.CommandClient {
+func NewCommandClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool, options ...ClientOptions) interfaces.CommandClient {
 	return &CommandClient{
 		baseUrl:               baseUrl,
-		authInjector:          authInjector,
+		authInjector:          utils.NewClientTransport(authInjector, options...),
 		enableNameFieldEscape: enableNameFieldEscape,
 	}
 }
@@ -47,6 +49,14 @@ func (client *CommandClient) AllDeviceCoreCommands(ctx context.Context, offset i
 	return res, nil
 }
 
+// IterAllDeviceCoreCommands iterates over the device core commands of AllDeviceCoreCommands, querying pageSize device core commands at a time
+func (client *CommandClient) IterAllDeviceCoreCommands(ctx context.Context, pageSize int) iter.Seq2[dtos.DeviceCoreCommand, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.DeviceCoreCommand, uint32, errors.EdgeX) {
+		res, err := client.AllDeviceCoreCommands(ctx, offset, limit)
+		return res.DeviceCoreCommands, res.TotalCount, err
+	})
+}
+
 // DeviceCoreCommandsByDeviceName returns all commands associated with the specified device name.
 func (client *CommandClient) DeviceCoreCommandsByDeviceName(ctx context.Context, name string) (
 	res responses.DeviceCoreCommandResponse, err errors.EdgeX) {
@@ -22,10 +22,10 @@ type commonClient struct {
 }
 
 // NewCommonClient creates an instance of CommonClient
-func NewCommonClient(baseUrl string, authInjector interfaces.AuthenticationInjector) interfaces.CommonClient {
+func NewCommonClient(baseUrl string, authInjector interfaces.AuthenticationInjector, options ...ClientOptions) interfaces.CommonClient {
 	return &commonClient{
 		baseUrl:      baseUrl,
-		authInjector: authInjector,
+		authInjector: utils.NewClientTransport(authInjector, options...),
 	}
 }
 
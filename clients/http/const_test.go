@@ -24,4 +24,6 @@ const (
 	TestCrontab         = "0 0 1 1 *"
 	TestTopic           = "TestTopic"
 	TestAddress         = "TestAddress"
+
+	TestRuleName = "TestRuleName"
 )
@@ -9,6 +9,7 @@ package http
 
 import (
 	"context"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -16,6 +17,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
@@ -29,10 +31,10 @@ type DeviceClient struct {
 }
 
 // NewDeviceClient creates an instance of DeviceClient
-func NewDeviceClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool) interfaces.DeviceClient {
+func NewDeviceClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool, options ...ClientOptions) interfaces.DeviceClient {
 	return &DeviceClient{
 		baseUrl:               baseUrl,
-		authInjector:          authInjector,
+		authInjector:          utils.NewClientTransport(authInjector, options...),
 		enableNameFieldEscape: enableNameFieldEscape,
 	}
 }
@@ -91,6 +93,14 @@ func (dc DeviceClient) AllDevices(ctx context.Context, labels []string, offset i
 	return res, nil
 }
 
+// IterAllDevices iterates over the devices of AllDevices, querying pageSize devices at a time
+func (dc DeviceClient) IterAllDevices(ctx context.Context, labels []string, pageSize int) iter.Seq2[dtos.Device, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Device, uint32, errors.EdgeX) {
+		res, err := dc.AllDevices(ctx, labels, offset, limit)
+		return res.Devices, res.TotalCount, err
+	})
+}
+
 func (dc DeviceClient) AllDevicesWithChildren(ctx context.Context, parent string, maxLevels uint, labels []string, offset int, limit int) (res responses.MultiDevicesResponse, err errors.EdgeX) {
 	requestParams := url.Values{}
 	if len(labels) > 0 {
@@ -107,6 +117,14 @@ func (dc DeviceClient) AllDevicesWithChildren(ctx context.Context, parent string
 	return res, nil
 }
 
+// IterAllDevicesWithChildren iterates over the devices of AllDevicesWithChildren, querying pageSize devices at a time
+func (dc DeviceClient) IterAllDevicesWithChildren(ctx context.Context, parent string, maxLevels uint, labels []string, pageSize int) iter.Seq2[dtos.Device, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Device, uint32, errors.EdgeX) {
+		res, err := dc.AllDevicesWithChildren(ctx, parent, maxLevels, labels, offset, limit)
+		return res.Devices, res.TotalCount, err
+	})
+}
+
 func (dc DeviceClient) DeviceNameExists(ctx context.Context, name string) (res dtoCommon.BaseResponse, err errors.EdgeX) {
 	path := common.NewPathBuilder().EnableNameFieldEscape(dc.enableNameFieldEscape).
 		SetPath(common.ApiDeviceRoute).SetPath(common.Check).SetPath(common.Name).SetNameFieldPath(name).BuildPath()
@@ -150,6 +168,14 @@ func (dc DeviceClient) DevicesByProfileName(ctx context.Context, name string, of
 	return res, nil
 }
 
+// IterDevicesByProfileName iterates over the devices of DevicesByProfileName, querying pageSize devices at a time
+func (dc DeviceClient) IterDevicesByProfileName(ctx context.Context, name string, pageSize int) iter.Seq2[dtos.Device, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Device, uint32, errors.EdgeX) {
+		res, err := dc.DevicesByProfileName(ctx, name, offset, limit)
+		return res.Devices, res.TotalCount, err
+	})
+}
+
 func (dc DeviceClient) DevicesByServiceName(ctx context.Context, name string, offset int, limit int) (res responses.MultiDevicesResponse, err errors.EdgeX) {
 	requestPath := common.NewPathBuilder().EnableNameFieldEscape(dc.enableNameFieldEscape).
 		SetPath(common.ApiDeviceRoute).SetPath(common.Service).SetPath(common.Name).SetNameFieldPath(name).BuildPath()
@@ -162,3 +188,11 @@ func (dc DeviceClient) DevicesByServiceName(ctx context.Context, name string, of
 	}
 	return res, nil
 }
+
+// IterDevicesByServiceName iterates over the devices of DevicesByServiceName, querying pageSize devices at a time
+func (dc DeviceClient) IterDevicesByServiceName(ctx context.Context, name string, pageSize int) iter.Seq2[dtos.Device, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Device, uint32, errors.EdgeX) {
+		res, err := dc.DevicesByServiceName(ctx, name, offset, limit)
+		return res.Devices, res.TotalCount, err
+	})
+}
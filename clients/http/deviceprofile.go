@@ -9,6 +9,7 @@ package http
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"path"
 	"strconv"
@@ -18,6 +19,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
@@ -33,10 +35,10 @@ type DeviceProfileClient struct {
 }
 
 // NewDeviceProfileClient creates an instance of DeviceProfileClient
-func NewDeviceProfileClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool) interfaces.DeviceProfileClient {
+func NewDeviceProfileClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool, options ...ClientOptions) interfaces.DeviceProfileClient {
 	return &DeviceProfileClient{
 		baseUrl:               baseUrl,
-		authInjector:          authInjector,
+		authInjector:          utils.NewClientTransport(authInjector, options...),
 		resourcesCache:        make(map[string]responses.DeviceResourceResponse),
 		enableNameFieldEscape: enableNameFieldEscape,
 	}
@@ -120,6 +122,14 @@ func (client *DeviceProfileClient) AllDeviceProfiles(ctx context.Context, labels
 	return res, nil
 }
 
+// IterAllDeviceProfiles iterates over the device profiles of AllDeviceProfiles, querying pageSize device profiles at a time
+func (client *DeviceProfileClient) IterAllDeviceProfiles(ctx context.Context, labels []string, pageSize int) iter.Seq2[dtos.DeviceProfile, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.DeviceProfile, uint32, errors.EdgeX) {
+		res, err := client.AllDeviceProfiles(ctx, labels, offset, limit)
+		return res.Profiles, res.TotalCount, err
+	})
+}
+
 // AllDeviceProfileBasicInfos queries the device profile basic infos with offset, and limit
 func (client *DeviceProfileClient) AllDeviceProfileBasicInfos(ctx context.Context, labels []string, offset int, limit int) (res responses.MultiDeviceProfileBasicInfoResponse, edgexError errors.EdgeX) {
 	requestParams := url.Values{}
@@ -135,6 +145,14 @@ func (client *DeviceProfileClient) AllDeviceProfileBasicInfos(ctx context.Contex
 	return res, nil
 }
 
+// IterAllDeviceProfileBasicInfos iterates over the device profile basic infos of AllDeviceProfileBasicInfos, querying pageSize device profile basic infos at a time
+func (client *DeviceProfileClient) IterAllDeviceProfileBasicInfos(ctx context.Context, labels []string, pageSize int) iter.Seq2[dtos.DeviceProfileBasicInfo, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.DeviceProfileBasicInfo, uint32, errors.EdgeX) {
+		res, err := client.AllDeviceProfileBasicInfos(ctx, labels, offset, limit)
+		return res.Profiles, res.TotalCount, err
+	})
+}
+
 // DeviceProfilesByModel queries the device profiles with offset, limit and model
 func (client *DeviceProfileClient) DeviceProfilesByModel(ctx context.Context, model string, offset int, limit int) (res responses.MultiDeviceProfilesResponse, edgexError errors.EdgeX) {
 	requestPath := path.Join(common.ApiDeviceProfileRoute, common.Model, model)
@@ -148,6 +166,14 @@ func (client *DeviceProfileClient) DeviceProfilesByModel(ctx context.Context, mo
 	return res, nil
 }
 
+// IterDeviceProfilesByModel iterates over the device profiles of DeviceProfilesByModel, querying pageSize device profiles at a time
+func (client *DeviceProfileClient) IterDeviceProfilesByModel(ctx context.Context, model string, pageSize int) iter.Seq2[dtos.DeviceProfile, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.DeviceProfile, uint32, errors.EdgeX) {
+		res, err := client.DeviceProfilesByModel(ctx, model, offset, limit)
+		return res.Profiles, res.TotalCount, err
+	})
+}
+
 // DeviceProfilesByManufacturer queries the device profiles with offset, limit and manufacturer
 func (client *DeviceProfileClient) DeviceProfilesByManufacturer(ctx context.Context, manufacturer string, offset int, limit int) (res responses.MultiDeviceProfilesResponse, edgexError errors.EdgeX) {
 	requestPath := path.Join(common.ApiDeviceProfileRoute, common.Manufacturer, manufacturer)
@@ -161,6 +187,14 @@ func (client *DeviceProfileClient) DeviceProfilesByManufacturer(ctx context.Cont
 	return res, nil
 }
 
+// IterDeviceProfilesByManufacturer iterates over the device profiles of DeviceProfilesByManufacturer, querying pageSize device profiles at a time
+func (client *DeviceProfileClient) IterDeviceProfilesByManufacturer(ctx context.Context, manufacturer string, pageSize int) iter.Seq2[dtos.DeviceProfile, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.DeviceProfile, uint32, errors.EdgeX) {
+		res, err := client.DeviceProfilesByManufacturer(ctx, manufacturer, offset, limit)
+		return res.Profiles, res.TotalCount, err
+	})
+}
+
 // DeviceProfilesByManufacturerAndModel queries the device profiles with offset, limit, manufacturer and model
 func (client *DeviceProfileClient) DeviceProfilesByManufacturerAndModel(ctx context.Context, manufacturer string, model string, offset int, limit int) (res responses.MultiDeviceProfilesResponse, edgexError errors.EdgeX) {
 	requestPath := path.Join(common.ApiDeviceProfileRoute, common.Manufacturer, manufacturer, common.Model, model)
@@ -174,6 +208,14 @@ func (client *DeviceProfileClient) DeviceProfilesByManufacturerAndModel(ctx cont
 	return res, nil
 }
 
+// IterDeviceProfilesByManufacturerAndModel iterates over the device profiles of DeviceProfilesByManufacturerAndModel, querying pageSize device profiles at a time
+func (client *DeviceProfileClient) IterDeviceProfilesByManufacturerAndModel(ctx context.Context, manufacturer string, model string, pageSize int) iter.Seq2[dtos.DeviceProfile, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.DeviceProfile, uint32, errors.EdgeX) {
+		res, err := client.DeviceProfilesByManufacturerAndModel(ctx, manufacturer, model, offset, limit)
+		return res.Profiles, res.TotalCount, err
+	})
+}
+
 // DeviceResourceByProfileNameAndResourceName queries the device resource by profileName and resourceName
 func (client *DeviceProfileClient) DeviceResourceByProfileNameAndResourceName(ctx context.Context, profileName string, resourceName string) (res responses.DeviceResourceResponse, edgexError errors.EdgeX) {
 	resourceMapKey := fmt.Sprintf("%s:%s", profileName, resourceName)
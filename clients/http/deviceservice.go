@@ -8,6 +8,7 @@ package http
 
 import (
 	"context"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -15,6 +16,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
@@ -28,10 +30,10 @@ type DeviceServiceClient struct {
 }
 
 // NewDeviceServiceClient creates an instance of DeviceServiceClient
-func NewDeviceServiceClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool) interfaces.DeviceServiceClient {
+func NewDeviceServiceClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool, options ...ClientOptions) interfaces.DeviceServiceClient {
 	return &DeviceServiceClient{
 		baseUrl:               baseUrl,
-		authInjector:          authInjector,
+		authInjector:          utils.NewClientTransport(authInjector, options...),
 		enableNameFieldEscape: enableNameFieldEscape,
 	}
 }
@@ -69,6 +71,14 @@ func (dsc DeviceServiceClient) AllDeviceServices(ctx context.Context, labels []s
 	return res, nil
 }
 
+// IterAllDeviceServices iterates over the device services of AllDeviceServices, querying pageSize device services at a time
+func (dsc DeviceServiceClient) IterAllDeviceServices(ctx context.Context, labels []string, pageSize int) iter.Seq2[dtos.DeviceService, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.DeviceService, uint32, errors.EdgeX) {
+		res, err := dsc.AllDeviceServices(ctx, labels, offset, limit)
+		return res.Services, res.TotalCount, err
+	})
+}
+
 func (dsc DeviceServiceClient) DeviceServiceByName(ctx context.Context, name string) (
 	res responses.DeviceServiceResponse, err errors.EdgeX) {
 	path := common.NewPathBuilder().EnableNameFieldEscape(dsc.enableNameFieldEscape).
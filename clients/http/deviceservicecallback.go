@@ -25,10 +25,10 @@ type deviceServiceCallbackClient struct {
 }
 
 // NewDeviceServiceCallbackClient creates an instance of deviceServiceCallbackClient
-func NewDeviceServiceCallbackClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool) interfaces.DeviceServiceCallbackClient {
+func NewDeviceServiceCallbackClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool, options ...ClientOptions) interfaces.DeviceServiceCallbackClient {
 	return &deviceServiceCallbackClient{
 		baseUrl:               baseUrl,
-		authInjector:          authInjector,
+		authInjector:          utils.NewClientTransport(authInjector, options...),
 		enableNameFieldEscape: enableNameFieldEscape,
 	}
 }
@@ -28,9 +28,9 @@ type deviceServiceCommandClient struct {
 }
 
 // NewDeviceServiceCommandClient creates an instance of deviceServiceCommandClient
-func NewDeviceServiceCommandClient(authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool) interfaces.DeviceServiceCommandClient {
+func NewDeviceServiceCommandClient(authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool, options ...ClientOptions) interfaces.DeviceServiceCommandClient {
 	return &deviceServiceCommandClient{
-		authInjector:          authInjector,
+		authInjector:          utils.NewClientTransport(authInjector, options...),
 		enableNameFieldEscape: enableNameFieldEscape,
 	}
 }
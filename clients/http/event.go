@@ -8,6 +8,7 @@ package http
 
 import (
 	"context"
+	"iter"
 	"net/url"
 	"path"
 	"strconv"
@@ -15,6 +16,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
@@ -28,10 +30,10 @@ type eventClient struct {
 }
 
 // NewEventClient creates an instance of EventClient
-func NewEventClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool) interfaces.EventClient {
+func NewEventClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool, options ...ClientOptions) interfaces.EventClient {
 	return &eventClient{
 		baseUrl:               baseUrl,
-		authInjector:          authInjector,
+		authInjector:          utils.NewClientTransport(authInjector, options...),
 		enableNameFieldEscape: enableNameFieldEscape,
 	}
 }
@@ -66,6 +68,14 @@ func (ec *eventClient) AllEvents(ctx context.Context, offset, limit int) (respon
 	return res, nil
 }
 
+// IterAllEvents iterates over the events of AllEvents, querying pageSize events at a time
+func (ec *eventClient) IterAllEvents(ctx context.Context, pageSize int) iter.Seq2[dtos.Event, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Event, uint32, errors.EdgeX) {
+		res, err := ec.AllEvents(ctx, offset, limit)
+		return res.Events, res.TotalCount, err
+	})
+}
+
 func (ec *eventClient) EventCount(ctx context.Context) (dtoCommon.CountResponse, errors.EdgeX) {
 	res := dtoCommon.CountResponse{}
 	err := utils.GetRequest(ctx, &res, ec.baseUrl, common.ApiEventCountRoute, nil, ec.authInjector)
@@ -99,6 +109,14 @@ func (ec *eventClient) EventsByDeviceName(ctx context.Context, name string, offs
 	return res, nil
 }
 
+// IterEventsByDeviceName iterates over the events of EventsByDeviceName, querying pageSize events at a time
+func (ec *eventClient) IterEventsByDeviceName(ctx context.Context, name string, pageSize int) iter.Seq2[dtos.Event, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Event, uint32, errors.EdgeX) {
+		res, err := ec.EventsByDeviceName(ctx, name, offset, limit)
+		return res.Events, res.TotalCount, err
+	})
+}
+
 func (ec *eventClient) DeleteByDeviceName(ctx context.Context, name string) (dtoCommon.BaseResponse, errors.EdgeX) {
 	path := path.Join(common.ApiEventRoute, common.Device, common.Name, name)
 	res := dtoCommon.BaseResponse{}
@@ -123,6 +141,14 @@ func (ec *eventClient) EventsByTimeRange(ctx context.Context, start, end int64,
 	return res, nil
 }
 
+// IterEventsByTimeRange iterates over the events of EventsByTimeRange, querying pageSize events at a time
+func (ec *eventClient) IterEventsByTimeRange(ctx context.Context, start int64, end int64, pageSize int) iter.Seq2[dtos.Event, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Event, uint32, errors.EdgeX) {
+		res, err := ec.EventsByTimeRange(ctx, start, end, offset, limit)
+		return res.Events, res.TotalCount, err
+	})
+}
+
 func (ec *eventClient) DeleteByAge(ctx context.Context, age int) (dtoCommon.BaseResponse, errors.EdgeX) {
 	path := path.Join(common.ApiEventRoute, common.Age, strconv.Itoa(age))
 	res := dtoCommon.BaseResponse{}
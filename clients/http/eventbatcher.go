@@ -0,0 +1,230 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+const (
+	defaultBatchMaxEvents     = 100
+	defaultBatchFlushInterval = time.Second
+	defaultBatchMaxInFlight   = 4
+	defaultBatchDrainTimeout  = 10 * time.Second
+)
+
+// EventBatcherConfig configures the EventBatcher
+type EventBatcherConfig struct {
+	// ServiceName is the name of the service which the events are added by
+	ServiceName string
+	// MaxEvents is the number of buffered events which triggers a flush, 100 if zero
+	MaxEvents int
+	// MaxBytes is the encoded size of the buffered events which triggers a flush, no limit if zero. The events are
+	// encoded once more to be measured, so it should be left zero unless the size matters.
+	MaxBytes int
+	// FlushInterval is the longest time an event stays buffered, 1s if zero
+	FlushInterval time.Duration
+	// MaxInFlight is the number of requests which may be in flight at once, 4 if zero
+	MaxInFlight int
+	// DrainTimeout is the longest time the buffered and in-flight events are still posted once the EventBatcher shuts
+	// down, 10s if zero. The requests still in flight by then are canceled, and the events not posted yet are dropped,
+	// both reported through OnResult with an error.
+	DrainTimeout time.Duration
+	// OnResult is called with the result of every event once it is posted or dropped, it may be called concurrently
+	OnResult func(EventResult)
+}
+
+// EventResult is the result of an event added to the EventBatcher
+type EventResult struct {
+	Request  requests.AddEventRequest
+	Response dtoCommon.BaseWithIdResponse
+	Err      errors.EdgeX
+}
+
+// EventBatcher buffers the events and posts them through the EventClient once MaxEvents or MaxBytes are buffered, or
+// FlushInterval elapses. It does not reduce the number of round trips: core data adds one event per request, so every
+// event of a flush is still posted in its own POST request. The batching only bounds the requests in flight, posting
+// the events of a flush concurrently up to MaxInFlight, and reports their results through OnResult rather than to the
+// callers of Add. The EventBatcher shuts down when the context it is created with is done, posting the buffered events
+// within DrainTimeout before Done is closed.
+type EventBatcher struct {
+	client interfaces.EventClient
+	config EventBatcherConfig
+	// ctx is used by the requests, it carries the values of the context the EventBatcher is created with but is not
+	// canceled along with it, so that the buffered events are still posted on shutdown until DrainTimeout elapses
+	ctx      context.Context
+	cancel   context.CancelFunc
+	inFlight chan struct{}
+	wg       sync.WaitGroup
+	done     chan struct{}
+
+	// lifecycle is held for reading while the events are added, and for writing once the EventBatcher shuts down
+	lifecycle sync.RWMutex
+	closed    bool
+
+	mutex         sync.Mutex
+	buffer        []requests.AddEventRequest
+	bufferedBytes int
+}
+
+// NewEventBatcher creates an instance of EventBatcher, which runs until ctx is done
+func NewEventBatcher(ctx context.Context, client interfaces.EventClient, config EventBatcherConfig) *EventBatcher {
+	if config.MaxEvents <= 0 {
+		config.MaxEvents = defaultBatchMaxEvents
+	}
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = defaultBatchFlushInterval
+	}
+	if config.MaxInFlight <= 0 {
+		config.MaxInFlight = defaultBatchMaxInFlight
+	}
+	if config.DrainTimeout <= 0 {
+		config.DrainTimeout = defaultBatchDrainTimeout
+	}
+	b := &EventBatcher{
+		client:   client,
+		config:   config,
+		inFlight: make(chan struct{}, config.MaxInFlight),
+		done:     make(chan struct{}),
+	}
+	b.ctx, b.cancel = context.WithCancel(context.WithoutCancel(ctx))
+	go b.run(ctx)
+	return b
+}
+
+// Add buffers the event, and flushes the buffer if it is full. The flush holds the events of the other callers too, so
+// it is posted with the context of the EventBatcher, and ctx only bounds how long Add blocks while MaxInFlight requests
+// are in flight. If ctx is done first, Add returns an error but the rest of the flush is still posted once requests
+// complete, and the results are reported through OnResult, so the event must not be added again.
+func (b *EventBatcher) Add(ctx context.Context, req requests.AddEventRequest) errors.EdgeX {
+	batch, edgexErr := b.bufferEvent(req)
+	if edgexErr != nil || len(batch) == 0 {
+		return edgexErr
+	}
+	defer b.wg.Done()
+	return b.post(ctx, batch)
+}
+
+// bufferEvent buffers the event and returns the buffered events if the buffer is full. The returned batch is counted by
+// the wait group before the lifecycle is released, so that the shutdown waits for it to be posted without holding the
+// lifecycle, and the caller must call wg.Done once it is posted.
+func (b *EventBatcher) bufferEvent(req requests.AddEventRequest) ([]requests.AddEventRequest, errors.EdgeX) {
+	b.lifecycle.RLock()
+	defer b.lifecycle.RUnlock()
+	if b.closed {
+		return nil, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "the event batcher is shut down", nil)
+	}
+
+	size := 0
+	if b.config.MaxBytes > 0 {
+		data, _, err := req.Encode()
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		size = len(data)
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.buffer = append(b.buffer, req)
+	b.bufferedBytes += size
+	if len(b.buffer) < b.config.MaxEvents && (b.config.MaxBytes <= 0 || b.bufferedBytes < b.config.MaxBytes) {
+		return nil, nil
+	}
+	b.wg.Add(1)
+	return b.take(), nil
+}
+
+// Done returns a channel which is closed once the EventBatcher is shut down and all the events are posted
+func (b *EventBatcher) Done() <-chan struct{} {
+	return b.done
+}
+
+func (b *EventBatcher) run(ctx context.Context) {
+	ticker := time.NewTicker(b.config.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			_ = b.post(b.ctx, b.flush())
+		case <-ctx.Done():
+			b.lifecycle.Lock()
+			b.closed = true
+			b.lifecycle.Unlock()
+			// the requests and the events still waiting to be posted are canceled once DrainTimeout elapses
+			drain := time.AfterFunc(b.config.DrainTimeout, b.cancel)
+			_ = b.post(b.ctx, b.flush())
+			b.wg.Wait()
+			drain.Stop()
+			b.cancel()
+			close(b.done)
+			return
+		}
+	}
+}
+
+// flush takes the buffered events
+func (b *EventBatcher) flush() []requests.AddEventRequest {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.take()
+}
+
+// take takes the buffered events, the mutex must be held by the caller
+func (b *EventBatcher) take() []requests.AddEventRequest {
+	batch := b.buffer
+	b.buffer = nil
+	b.bufferedBytes = 0
+	return batch
+}
+
+// post posts each event of the batch with the context of the EventBatcher once a request can be in flight. If ctx is
+// done first, the events which are not posted yet are handed over to a goroutine which keeps waiting for them, and an
+// error is returned. If the context of the EventBatcher is done, i.e. the shutdown drain timed out, the events which
+// are not posted yet are dropped and reported through OnResult.
+func (b *EventBatcher) post(ctx context.Context, batch []requests.AddEventRequest) errors.EdgeX {
+	for i, req := range batch {
+		select {
+		case b.inFlight <- struct{}{}:
+		case <-b.ctx.Done():
+			err := errors.NewCommonEdgeX(errors.KindServiceUnavailable, "the event is dropped as the event batcher drain timed out", b.ctx.Err())
+			for _, dropped := range batch[i:] {
+				b.report(EventResult{Request: dropped, Err: err})
+			}
+			return err
+		case <-ctx.Done():
+			pending := batch[i:]
+			b.wg.Add(1)
+			go func() {
+				defer b.wg.Done()
+				_ = b.post(b.ctx, pending)
+			}()
+			return errors.NewCommonEdgeX(errors.KindServiceUnavailable,
+				"the events are not posted yet when the context is done, they are posted once the requests in flight complete", ctx.Err())
+		}
+		b.wg.Add(1)
+		go func() {
+			defer b.wg.Done()
+			res, err := b.client.Add(b.ctx, b.config.ServiceName, req)
+			<-b.inFlight
+			b.report(EventResult{Request: req, Response: res, Err: err})
+		}()
+	}
+	return nil
+}
+
+func (b *EventBatcher) report(result EventResult) {
+	if b.config.OnResult != nil {
+		b.config.OnResult(result)
+	}
+}
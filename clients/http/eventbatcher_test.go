@@ -0,0 +1,244 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEventClient records the events it adds and the highest number of concurrent requests, and blocks each request
+// until release is closed or its context is done
+type fakeEventClient struct {
+	interfaces.EventClient
+	release     chan struct{}
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+	mutex       sync.Mutex
+	added       []string
+}
+
+func newFakeEventClient() *fakeEventClient {
+	client := &fakeEventClient{release: make(chan struct{})}
+	close(client.release)
+	return client
+}
+
+func (c *fakeEventClient) Add(ctx context.Context, serviceName string, req requests.AddEventRequest) (dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	n := c.inFlight.Add(1)
+	defer c.inFlight.Add(-1)
+	for highest := c.maxInFlight.Load(); n > highest && !c.maxInFlight.CompareAndSwap(highest, n); highest = c.maxInFlight.Load() {
+	}
+	select {
+	case <-c.release:
+	case <-ctx.Done():
+		return dtoCommon.BaseWithIdResponse{}, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "request canceled", ctx.Err())
+	}
+	c.mutex.Lock()
+	c.added = append(c.added, req.Event.Id)
+	c.mutex.Unlock()
+	return dtoCommon.NewBaseWithIdResponse(req.RequestId, "", http.StatusCreated, req.Event.Id), nil
+}
+
+// resultCollector collects the results reported by the EventBatcher
+type resultCollector struct {
+	mutex   sync.Mutex
+	results []EventResult
+}
+
+func (c *resultCollector) onResult(result EventResult) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.results = append(c.results, result)
+}
+
+func (c *resultCollector) count() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.results)
+}
+
+func testAddEventRequest() requests.AddEventRequest {
+	event := dtos.NewEvent(TestDeviceProfileName, TestDeviceName, TestCommandName)
+	_ = event.AddSimpleReading(TestCommandName, common.ValueTypeInt32, int32(1))
+	return requests.NewAddEventRequest(event)
+}
+
+func TestEventBatcher_flush(t *testing.T) {
+	size := func() int {
+		req := testAddEventRequest()
+		data, _, err := req.Encode()
+		require.NoError(t, err)
+		return len(data)
+	}()
+	tests := []struct {
+		name          string
+		config        EventBatcherConfig
+		events        int
+		expectedAdded int
+	}{
+		{"flush by count", EventBatcherConfig{MaxEvents: 2, FlushInterval: time.Hour}, 5, 4},
+		{"flush by bytes", EventBatcherConfig{MaxEvents: 100, MaxBytes: 3 * size, FlushInterval: time.Hour}, 5, 3},
+		{"flush by interval", EventBatcherConfig{MaxEvents: 100, FlushInterval: 10 * time.Millisecond}, 5, 5},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			client := newFakeEventClient()
+			collector := &resultCollector{}
+			testCase.config.ServiceName = "testService"
+			testCase.config.OnResult = collector.onResult
+			batcher := NewEventBatcher(ctx, client, testCase.config)
+
+			for i := 0; i < testCase.events; i++ {
+				require.NoError(t, batcher.Add(context.Background(), testAddEventRequest()))
+			}
+			require.Eventually(t, func() bool { return collector.count() == testCase.expectedAdded }, time.Second, time.Millisecond)
+			time.Sleep(20 * time.Millisecond)
+			assert.Equal(t, testCase.expectedAdded, collector.count())
+			for _, result := range collector.results {
+				require.NoError(t, result.Err)
+				assert.Equal(t, result.Request.Event.Id, result.Response.Id)
+			}
+		})
+	}
+}
+
+func TestEventBatcher_maxInFlight(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := &fakeEventClient{release: make(chan struct{})}
+	collector := &resultCollector{}
+	batcher := NewEventBatcher(ctx, client, EventBatcherConfig{MaxEvents: 1, MaxInFlight: 2, OnResult: collector.onResult})
+
+	require.NoError(t, batcher.Add(context.Background(), testAddEventRequest()))
+	require.NoError(t, batcher.Add(context.Background(), testAddEventRequest()))
+	addCtx, addCancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer addCancel()
+	err := batcher.Add(addCtx, testAddEventRequest())
+	require.Error(t, err, "the third event should wait for a request to be in flight until its context is done")
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+
+	close(client.release)
+	require.Eventually(t, func() bool { return collector.count() == 3 }, time.Second, time.Millisecond)
+	assert.EqualValues(t, 2, client.maxInFlight.Load())
+	for _, result := range collector.results {
+		assert.NoError(t, result.Err, "the third event should still be posted once a request completes")
+	}
+}
+
+func TestEventBatcher_canceledCaller(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := &fakeEventClient{release: make(chan struct{})}
+	collector := &resultCollector{}
+	batcher := NewEventBatcher(ctx, client, EventBatcherConfig{MaxEvents: 3, MaxInFlight: 1, FlushInterval: time.Hour, OnResult: collector.onResult})
+
+	var expected []string
+	for i := 0; i < 2; i++ {
+		req := testAddEventRequest()
+		expected = append(expected, req.Event.Id)
+		require.NoError(t, batcher.Add(context.Background(), req))
+	}
+	// the third event fills the buffer, and its caller gives up while the first event is in flight
+	req := testAddEventRequest()
+	expected = append(expected, req.Event.Id)
+	addCtx, addCancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer addCancel()
+	require.Error(t, batcher.Add(addCtx, req))
+
+	close(client.release)
+	require.Eventually(t, func() bool { return collector.count() == 3 }, time.Second, time.Millisecond)
+	for _, result := range collector.results {
+		require.NoError(t, result.Err, "the events of the other callers should not be dropped")
+	}
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	assert.ElementsMatch(t, expected, client.added)
+}
+
+func TestEventBatcher_shutdown(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	client := &fakeEventClient{release: make(chan struct{})}
+	collector := &resultCollector{}
+	batcher := NewEventBatcher(ctx, client, EventBatcherConfig{MaxEvents: 2, FlushInterval: time.Hour, OnResult: collector.onResult})
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, batcher.Add(context.Background(), testAddEventRequest()))
+	}
+	cancel()
+	select {
+	case <-batcher.Done():
+		t.Fatal("the batcher should not be done while the events are in flight")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(client.release)
+	select {
+	case <-batcher.Done():
+	case <-time.After(time.Second):
+		t.Fatal("the batcher should be done once the events are posted")
+	}
+	assert.Len(t, client.added, 3)
+	assert.Equal(t, 3, collector.count())
+
+	err := batcher.Add(context.Background(), testAddEventRequest())
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+}
+
+func TestEventBatcher_drainTimeout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	client := &fakeEventClient{release: make(chan struct{})}
+	defer close(client.release)
+	collector := &resultCollector{}
+	batcher := NewEventBatcher(ctx, client, EventBatcherConfig{MaxEvents: 2, MaxInFlight: 1, FlushInterval: time.Hour,
+		DrainTimeout: 50 * time.Millisecond, OnResult: collector.onResult})
+
+	// the first batch holds the only request in flight, and its caller waits for the second event forever
+	blocked := make(chan errors.EdgeX)
+	go func() {
+		_ = batcher.Add(context.Background(), testAddEventRequest())
+		blocked <- batcher.Add(context.Background(), testAddEventRequest())
+	}()
+	require.Eventually(t, func() bool { return client.inFlight.Load() == 1 }, time.Second, time.Millisecond)
+	require.NoError(t, batcher.Add(context.Background(), testAddEventRequest()))
+
+	cancel()
+	require.Eventually(t, func() bool {
+		err := batcher.Add(context.Background(), testAddEventRequest())
+		return errors.Kind(err) == errors.KindServiceUnavailable
+	}, time.Second, time.Millisecond, "the blocked caller should not keep the new callers waiting")
+	select {
+	case <-batcher.Done():
+	case <-time.After(time.Second):
+		t.Fatal("the batcher should be done once the drain times out")
+	}
+
+	err := <-blocked
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+	require.Equal(t, 3, collector.count())
+	for _, result := range collector.results {
+		assert.Error(t, result.Err, "the events still waiting when the drain times out should be reported as failed")
+	}
+	assert.Empty(t, client.added)
+}
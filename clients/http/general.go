@@ -21,10 +21,10 @@ type generalClient struct {
 	authInjector interfaces.AuthenticationInjector
 }
 
-func NewGeneralClient(baseUrl string, authInjector interfaces.AuthenticationInjector) interfaces.GeneralClient {
+func NewGeneralClient(baseUrl string, authInjector interfaces.AuthenticationInjector, options ...ClientOptions) interfaces.GeneralClient {
 	return &generalClient{
 		baseUrl:      baseUrl,
-		authInjector: authInjector,
+		authInjector: utils.NewClientTransport(authInjector, options...),
 	}
 }
 
@@ -25,10 +25,10 @@ type KVSClient struct {
 }
 
 // NewKVSClient creates an instance of KVSClient
-func NewKVSClient(baseUrl string, authInjector interfaces.AuthenticationInjector) interfaces.KVSClient {
+func NewKVSClient(baseUrl string, authInjector interfaces.AuthenticationInjector, options ...ClientOptions) interfaces.KVSClient {
 	return &KVSClient{
 		baseUrl:      baseUrl,
-		authInjector: authInjector,
+		authInjector: utils.NewClientTransport(authInjector, options...),
 	}
 }
 
@@ -8,6 +8,7 @@ package http
 
 import (
 	"context"
+	"iter"
 	"net/url"
 	"path"
 	"strconv"
@@ -16,6 +17,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
@@ -29,10 +31,10 @@ type NotificationClient struct {
 }
 
 // NewNotificationClient creates an instance of NotificationClient
-func NewNotificationClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool) interfaces.NotificationClient {
+func NewNotificationClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool, options ...ClientOptions) interfaces.NotificationClient {
 	return &NotificationClient{
 		baseUrl:               baseUrl,
-		authInjector:          authInjector,
+		authInjector:          utils.NewClientTransport(authInjector, options...),
 		enableNameFieldEscape: enableNameFieldEscape,
 	}
 }
@@ -80,6 +82,14 @@ func (client *NotificationClient) NotificationsByCategory(ctx context.Context, c
 	return res, nil
 }
 
+// IterNotificationsByCategory iterates over the notifications of NotificationsByCategory, querying pageSize notifications at a time
+func (client *NotificationClient) IterNotificationsByCategory(ctx context.Context, category string, pageSize int, ack string) iter.Seq2[dtos.Notification, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Notification, uint32, errors.EdgeX) {
+		res, err := client.NotificationsByCategory(ctx, category, offset, limit, ack)
+		return res.Notifications, res.TotalCount, err
+	})
+}
+
 // NotificationsByLabel queries notifications with label, offset and limit
 func (client *NotificationClient) NotificationsByLabel(ctx context.Context, label string, offset int, limit int, ack string) (res responses.MultiNotificationsResponse, err errors.EdgeX) {
 	requestPath := path.Join(common.ApiNotificationRoute, common.Label, label)
@@ -94,6 +104,14 @@ func (client *NotificationClient) NotificationsByLabel(ctx context.Context, labe
 	return res, nil
 }
 
+// IterNotificationsByLabel iterates over the notifications of NotificationsByLabel, querying pageSize notifications at a time
+func (client *NotificationClient) IterNotificationsByLabel(ctx context.Context, label string, pageSize int, ack string) iter.Seq2[dtos.Notification, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Notification, uint32, errors.EdgeX) {
+		res, err := client.NotificationsByLabel(ctx, label, offset, limit, ack)
+		return res.Notifications, res.TotalCount, err
+	})
+}
+
 // NotificationsByStatus queries notifications with status, offset and limit
 func (client *NotificationClient) NotificationsByStatus(ctx context.Context, status string, offset int, limit int, ack string) (res responses.MultiNotificationsResponse, err errors.EdgeX) {
 	requestPath := path.Join(common.ApiNotificationRoute, common.Status, status)
@@ -108,6 +126,14 @@ func (client *NotificationClient) NotificationsByStatus(ctx context.Context, sta
 	return res, nil
 }
 
+// IterNotificationsByStatus iterates over the notifications of NotificationsByStatus, querying pageSize notifications at a time
+func (client *NotificationClient) IterNotificationsByStatus(ctx context.Context, status string, pageSize int, ack string) iter.Seq2[dtos.Notification, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Notification, uint32, errors.EdgeX) {
+		res, err := client.NotificationsByStatus(ctx, status, offset, limit, ack)
+		return res.Notifications, res.TotalCount, err
+	})
+}
+
 // NotificationsByTimeRange query notifications with time range, offset and limit
 func (client *NotificationClient) NotificationsByTimeRange(ctx context.Context, start int64, end int64, offset int, limit int, ack string) (res responses.MultiNotificationsResponse, err errors.EdgeX) {
 	requestPath := path.Join(common.ApiNotificationRoute, common.Start, strconv.FormatInt(start, 10), common.End, strconv.FormatInt(end, 10))
@@ -122,6 +148,14 @@ func (client *NotificationClient) NotificationsByTimeRange(ctx context.Context,
 	return res, nil
 }
 
+// IterNotificationsByTimeRange iterates over the notifications of NotificationsByTimeRange, querying pageSize notifications at a time
+func (client *NotificationClient) IterNotificationsByTimeRange(ctx context.Context, start int64, end int64, pageSize int, ack string) iter.Seq2[dtos.Notification, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Notification, uint32, errors.EdgeX) {
+		res, err := client.NotificationsByTimeRange(ctx, start, end, offset, limit, ack)
+		return res.Notifications, res.TotalCount, err
+	})
+}
+
 // NotificationsBySubscriptionName query notifications with subscriptionName, offset and limit
 func (client *NotificationClient) NotificationsBySubscriptionName(ctx context.Context, subscriptionName string, offset int, limit int, ack string) (res responses.MultiNotificationsResponse, err errors.EdgeX) {
 	requestPath := path.Join(common.ApiNotificationRoute, common.Subscription, common.Name, subscriptionName)
@@ -136,6 +170,14 @@ func (client *NotificationClient) NotificationsBySubscriptionName(ctx context.Co
 	return res, nil
 }
 
+// IterNotificationsBySubscriptionName iterates over the notifications of NotificationsBySubscriptionName, querying pageSize notifications at a time
+func (client *NotificationClient) IterNotificationsBySubscriptionName(ctx context.Context, subscriptionName string, pageSize int, ack string) iter.Seq2[dtos.Notification, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Notification, uint32, errors.EdgeX) {
+		res, err := client.NotificationsBySubscriptionName(ctx, subscriptionName, offset, limit, ack)
+		return res.Notifications, res.TotalCount, err
+	})
+}
+
 // CleanupNotificationsByAge removes notifications that are older than age. And the corresponding transmissions will also be deleted.
 // Age is supposed in milliseconds since modified timestamp
 func (client *NotificationClient) CleanupNotificationsByAge(ctx context.Context, age int) (res dtoCommon.BaseResponse, err errors.EdgeX) {
@@ -181,6 +223,14 @@ func (client *NotificationClient) NotificationsByQueryConditions(ctx context.Con
 	return res, nil
 }
 
+// IterNotificationsByQueryConditions iterates over the notifications of NotificationsByQueryConditions, querying pageSize notifications at a time
+func (client *NotificationClient) IterNotificationsByQueryConditions(ctx context.Context, pageSize int, ack string, conditionReq requests.GetNotificationRequest) iter.Seq2[dtos.Notification, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Notification, uint32, errors.EdgeX) {
+		res, err := client.NotificationsByQueryConditions(ctx, offset, limit, ack, conditionReq)
+		return res.Notifications, res.TotalCount, err
+	})
+}
+
 // DeleteNotificationByIds deletes notifications by ids
 func (client *NotificationClient) DeleteNotificationByIds(ctx context.Context, ids []string) (res dtoCommon.BaseResponse, err errors.EdgeX) {
 	path := utils.EscapeAndJoinPath(common.ApiNotificationRoute, common.Ids, strings.Join(ids, common.CommaSeparator))
@@ -0,0 +1,47 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import "github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
+
+// ClientOptions configures the HTTP transport of the clients, e.g. a shared http.Client, the default timeout of every
+// request, the user agent, extra headers and request/response hooks. The options passed to a New*Client constructor
+// are merged in order, later non-zero fields override earlier ones, headers are merged and hooks are appended.
+type ClientOptions = utils.ClientOptions
+
+// RequestHook is called with every request before it is sent, the request is aborted if the hook returns an error
+type RequestHook = utils.RequestHook
+
+// ResponseHook is called after every request with the response, or with the error if the request failed
+type ResponseHook = utils.ResponseHook
+
+// RetryPolicy retries the failed requests with exponential backoff and jitter, see utils.RetryPolicy
+type RetryPolicy = utils.RetryPolicy
+
+// CircuitBreakers keeps a circuit breaker per base URL, see utils.CircuitBreakers
+type CircuitBreakers = utils.CircuitBreakers
+
+// CircuitBreakerPolicy configures the CircuitBreakers
+type CircuitBreakerPolicy = utils.CircuitBreakerPolicy
+
+// CircuitState is the state of the circuit breaker of a base URL
+type CircuitState = utils.CircuitState
+
+const (
+	CircuitClosed   = utils.CircuitClosed
+	CircuitOpen     = utils.CircuitOpen
+	CircuitHalfOpen = utils.CircuitHalfOpen
+)
+
+// DefaultRetryPolicy returns a RetryPolicy of 3 attempts, which waits 100ms, then 200ms, with 20% jitter
+func DefaultRetryPolicy() *RetryPolicy {
+	return utils.DefaultRetryPolicy()
+}
+
+// NewCircuitBreakers creates an instance of CircuitBreakers, which can be shared by the clients through ClientOptions
+func NewCircuitBreakers(policy CircuitBreakerPolicy) *CircuitBreakers {
+	return utils.NewCircuitBreakers(policy)
+}
@@ -0,0 +1,48 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientOptions(t *testing.T) {
+	var userAgent, site string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent, site = r.Header.Get("User-Agent"), r.Header.Get("X-Site")
+		_ = json.NewEncoder(w).Encode(dtoCommon.NewPingResponse(""))
+	}))
+	defer ts.Close()
+
+	var statusCode int
+	options := ClientOptions{
+		UserAgent: "edgex-ui/4.0",
+		Headers:   map[string]string{"X-Site": "plant-1"},
+		ResponseHooks: []ResponseHook{func(_ *http.Request, resp *http.Response, _ error) {
+			statusCode = resp.StatusCode
+		}},
+	}
+	client := NewCommonClient(ts.URL, nil, options)
+	_, err := client.Ping(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "edgex-ui/4.0", userAgent)
+	assert.Equal(t, "plant-1", site)
+	assert.Equal(t, http.StatusOK, statusCode)
+
+	deviceClient := NewDeviceClient(ts.URL, NewNullAuthenticationInjector(), false, options)
+	_, err = deviceClient.DeviceNameExists(context.Background(), "device")
+	require.NoError(t, err)
+	assert.Equal(t, "edgex-ui/4.0", userAgent)
+}
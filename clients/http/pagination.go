@@ -0,0 +1,50 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// pageFunc queries the page of the offset and limit, and returns its items along with the total count of the items
+type pageFunc[T any] func(offset, limit int) ([]T, uint32, errors.EdgeX)
+
+// iterPages returns an iterator over the items of the pages queried by page, which queries pageSize items at a time,
+// or common.DefaultLimit if pageSize is not positive, until the total count is reached or a page is empty. The
+// iteration stops at the first error, which is yielded with the zero item, including the error of the canceled ctx.
+func iterPages[T any](ctx context.Context, pageSize int, page pageFunc[T]) iter.Seq2[T, errors.EdgeX] {
+	if pageSize <= 0 {
+		pageSize = common.DefaultLimit
+	}
+	return func(yield func(T, errors.EdgeX) bool) {
+		var zero T
+		for offset := 0; ; {
+			if err := ctx.Err(); err != nil {
+				yield(zero, errors.NewCommonEdgeX(errors.KindServiceUnavailable, fmt.Sprintf("the iteration is canceled at offset %d", offset), err))
+				return
+			}
+			items, totalCount, err := page(offset, pageSize)
+			if err != nil {
+				yield(zero, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query the page at offset %d", offset), err))
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			offset += len(items)
+			if len(items) == 0 || offset >= int(totalCount) {
+				return
+			}
+		}
+	}
+}
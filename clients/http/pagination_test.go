@@ -0,0 +1,130 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pagesOf returns a pageFunc over the items, which records the offsets it is queried with and fails at failAt
+func pagesOf(items []int, failAt int, offsets *[]int) pageFunc[int] {
+	return func(offset, limit int) ([]int, uint32, errors.EdgeX) {
+		*offsets = append(*offsets, offset)
+		if offset == failAt {
+			return nil, 0, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "unavailable", nil)
+		}
+		end := min(offset+limit, len(items))
+		return items[offset:end], uint32(len(items)), nil
+	}
+}
+
+func TestIterPages(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	tests := []struct {
+		name            string
+		pageSize        int
+		failAt          int
+		expected        []int
+		expectedOffsets []int
+		errorExpected   bool
+	}{
+		{"pages until the total count", 2, -1, items, []int{0, 2, 4}, false},
+		{"single page", 10, -1, items, []int{0}, false},
+		{"default page size", 0, -1, items, []int{0}, false},
+		{"error of a page", 2, 2, []int{1, 2}, []int{0, 2}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var offsets []int
+			var result []int
+			var iterErr errors.EdgeX
+			for item, err := range iterPages(context.Background(), testCase.pageSize, pagesOf(items, testCase.failAt, &offsets)) {
+				if err != nil {
+					iterErr = err
+					continue
+				}
+				result = append(result, item)
+			}
+			assert.Equal(t, testCase.expected, result)
+			assert.Equal(t, testCase.expectedOffsets, offsets)
+			if testCase.errorExpected {
+				require.Error(t, iterErr)
+				assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(iterErr))
+				assert.Contains(t, iterErr.Error(), "offset 2")
+			} else {
+				require.NoError(t, iterErr)
+			}
+		})
+	}
+}
+
+func TestIterPages_break(t *testing.T) {
+	var offsets []int
+	for item, err := range iterPages(context.Background(), 2, pagesOf([]int{1, 2, 3, 4, 5}, -1, &offsets)) {
+		require.NoError(t, err)
+		if item == 3 {
+			break
+		}
+	}
+	assert.Equal(t, []int{0, 2}, offsets)
+}
+
+func TestIterPages_canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var offsets []int
+	var result []int
+	var iterErr errors.EdgeX
+	for item, err := range iterPages(ctx, 2, pagesOf([]int{1, 2, 3, 4, 5}, -1, &offsets)) {
+		if err != nil {
+			iterErr = err
+			break
+		}
+		result = append(result, item)
+		cancel()
+	}
+	assert.Equal(t, []int{1, 2}, result)
+	assert.Equal(t, []int{0}, offsets)
+	require.Error(t, iterErr)
+}
+
+func TestIterAllDevices(t *testing.T) {
+	devices := make([]dtos.Device, 5)
+	for i := range devices {
+		devices[i].Name = fmt.Sprintf("device-%d", i)
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, common.ApiAllDeviceRoute, r.URL.Path)
+		assert.Equal(t, "label1", r.URL.Query().Get(common.Labels))
+		offset, _ := strconv.Atoi(r.URL.Query().Get(common.Offset))
+		limit, _ := strconv.Atoi(r.URL.Query().Get(common.Limit))
+		res := responses.NewMultiDevicesResponse("", "", http.StatusOK, uint32(len(devices)), devices[offset:min(offset+limit, len(devices))])
+		_ = json.NewEncoder(w).Encode(res)
+	}))
+	defer ts.Close()
+
+	client := NewDeviceClient(ts.URL, NewNullAuthenticationInjector(), false)
+	var names []string
+	for device, err := range client.IterAllDevices(context.Background(), []string{"label1"}, 2) {
+		require.NoError(t, err)
+		names = append(names, device.Name)
+	}
+	assert.Equal(t, []string{"device-0", "device-1", "device-2", "device-3", "device-4"}, names)
+}
@@ -8,6 +8,7 @@ package http
 
 import (
 	"context"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -15,6 +16,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
@@ -28,10 +30,10 @@ type ProvisionWatcherClient struct {
 }
 
 // NewProvisionWatcherClient creates an instance of ProvisionWatcherClient
-func NewProvisionWatcherClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool) interfaces.ProvisionWatcherClient {
+func NewProvisionWatcherClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool, options ...ClientOptions) interfaces.ProvisionWatcherClient {
 	return &ProvisionWatcherClient{
 		baseUrl:               baseUrl,
-		authInjector:          authInjector,
+		authInjector:          utils.NewClientTransport(authInjector, options...),
 		enableNameFieldEscape: enableNameFieldEscape,
 	}
 }
@@ -69,6 +71,14 @@ func (pwc ProvisionWatcherClient) AllProvisionWatchers(ctx context.Context, labe
 	return
 }
 
+// IterAllProvisionWatchers iterates over the provision watchers of AllProvisionWatchers, querying pageSize provision watchers at a time
+func (pwc ProvisionWatcherClient) IterAllProvisionWatchers(ctx context.Context, labels []string, pageSize int) iter.Seq2[dtos.ProvisionWatcher, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.ProvisionWatcher, uint32, errors.EdgeX) {
+		res, err := pwc.AllProvisionWatchers(ctx, labels, offset, limit)
+		return res.ProvisionWatchers, res.TotalCount, err
+	})
+}
+
 func (pwc ProvisionWatcherClient) ProvisionWatcherByName(ctx context.Context, name string) (res responses.ProvisionWatcherResponse, err errors.EdgeX) {
 	path := common.NewPathBuilder().EnableNameFieldEscape(pwc.enableNameFieldEscape).
 		SetPath(common.ApiProvisionWatcherRoute).SetPath(common.Name).SetNameFieldPath(name).BuildPath()
@@ -105,6 +115,14 @@ func (pwc ProvisionWatcherClient) ProvisionWatchersByProfileName(ctx context.Con
 	return
 }
 
+// IterProvisionWatchersByProfileName iterates over the provision watchers of ProvisionWatchersByProfileName, querying pageSize provision watchers at a time
+func (pwc ProvisionWatcherClient) IterProvisionWatchersByProfileName(ctx context.Context, name string, pageSize int) iter.Seq2[dtos.ProvisionWatcher, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.ProvisionWatcher, uint32, errors.EdgeX) {
+		res, err := pwc.ProvisionWatchersByProfileName(ctx, name, offset, limit)
+		return res.ProvisionWatchers, res.TotalCount, err
+	})
+}
+
 func (pwc ProvisionWatcherClient) ProvisionWatchersByServiceName(ctx context.Context, name string, offset int, limit int) (res responses.MultiProvisionWatchersResponse, err errors.EdgeX) {
 	requestPath := common.NewPathBuilder().EnableNameFieldEscape(pwc.enableNameFieldEscape).
 		SetPath(common.ApiProvisionWatcherRoute).SetPath(common.Service).SetPath(common.Name).SetNameFieldPath(name).BuildPath()
@@ -118,3 +136,11 @@ func (pwc ProvisionWatcherClient) ProvisionWatchersByServiceName(ctx context.Con
 
 	return
 }
+
+// IterProvisionWatchersByServiceName iterates over the provision watchers of ProvisionWatchersByServiceName, querying pageSize provision watchers at a time
+func (pwc ProvisionWatcherClient) IterProvisionWatchersByServiceName(ctx context.Context, name string, pageSize int) iter.Seq2[dtos.ProvisionWatcher, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.ProvisionWatcher, uint32, errors.EdgeX) {
+		res, err := pwc.ProvisionWatchersByServiceName(ctx, name, offset, limit)
+		return res.ProvisionWatchers, res.TotalCount, err
+	})
+}
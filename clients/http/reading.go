@@ -8,6 +8,7 @@ package http
 
 import (
 	"context"
+	"iter"
 	"net/url"
 	"path"
 	"strconv"
@@ -15,6 +16,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -27,10 +29,10 @@ type readingClient struct {
 }
 
 // NewReadingClient creates an instance of ReadingClient
-func NewReadingClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool) interfaces.ReadingClient {
+func NewReadingClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool, options ...ClientOptions) interfaces.ReadingClient {
 	return &readingClient{
 		baseUrl:               baseUrl,
-		authInjector:          authInjector,
+		authInjector:          utils.NewClientTransport(authInjector, options...),
 		enableNameFieldEscape: enableNameFieldEscape,
 	}
 }
@@ -47,6 +49,14 @@ func (rc readingClient) AllReadings(ctx context.Context, offset, limit int) (res
 	return res, nil
 }
 
+// IterAllReadings iterates over the readings of AllReadings, querying pageSize readings at a time
+func (rc readingClient) IterAllReadings(ctx context.Context, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.BaseReading, uint32, errors.EdgeX) {
+		res, err := rc.AllReadings(ctx, offset, limit)
+		return res.Readings, res.TotalCount, err
+	})
+}
+
 func (rc readingClient) ReadingCount(ctx context.Context) (dtoCommon.CountResponse, errors.EdgeX) {
 	res := dtoCommon.CountResponse{}
 	err := utils.GetRequest(ctx, &res, rc.baseUrl, common.ApiReadingCountRoute, nil, rc.authInjector)
@@ -79,6 +89,14 @@ func (rc readingClient) ReadingsByDeviceName(ctx context.Context, name string, o
 	return res, nil
 }
 
+// IterReadingsByDeviceName iterates over the readings of ReadingsByDeviceName, querying pageSize readings at a time
+func (rc readingClient) IterReadingsByDeviceName(ctx context.Context, name string, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.BaseReading, uint32, errors.EdgeX) {
+		res, err := rc.ReadingsByDeviceName(ctx, name, offset, limit)
+		return res.Readings, res.TotalCount, err
+	})
+}
+
 func (rc readingClient) ReadingsByResourceName(ctx context.Context, name string, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
 	requestPath := common.NewPathBuilder().EnableNameFieldEscape(rc.enableNameFieldEscape).
 		SetPath(common.ApiReadingRoute).SetPath(common.ResourceName).SetNameFieldPath(name).BuildPath()
@@ -93,6 +111,14 @@ func (rc readingClient) ReadingsByResourceName(ctx context.Context, name string,
 	return res, nil
 }
 
+// IterReadingsByResourceName iterates over the readings of ReadingsByResourceName, querying pageSize readings at a time
+func (rc readingClient) IterReadingsByResourceName(ctx context.Context, name string, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.BaseReading, uint32, errors.EdgeX) {
+		res, err := rc.ReadingsByResourceName(ctx, name, offset, limit)
+		return res.Readings, res.TotalCount, err
+	})
+}
+
 func (rc readingClient) ReadingsByTimeRange(ctx context.Context, start, end int64, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
 	requestPath := path.Join(common.ApiReadingRoute, common.Start, strconv.FormatInt(start, 10), common.End, strconv.FormatInt(end, 10))
 	requestParams := url.Values{}
@@ -106,6 +132,14 @@ func (rc readingClient) ReadingsByTimeRange(ctx context.Context, start, end int6
 	return res, nil
 }
 
+// IterReadingsByTimeRange iterates over the readings of ReadingsByTimeRange, querying pageSize readings at a time
+func (rc readingClient) IterReadingsByTimeRange(ctx context.Context, start int64, end int64, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.BaseReading, uint32, errors.EdgeX) {
+		res, err := rc.ReadingsByTimeRange(ctx, start, end, offset, limit)
+		return res.Readings, res.TotalCount, err
+	})
+}
+
 // ReadingsByResourceNameAndTimeRange returns readings by resource name and specified time range. Readings are sorted in descending order of origin time.
 func (rc readingClient) ReadingsByResourceNameAndTimeRange(ctx context.Context, name string, start, end int64, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
 	requestPath := common.NewPathBuilder().EnableNameFieldEscape(rc.enableNameFieldEscape).
@@ -121,6 +155,14 @@ func (rc readingClient) ReadingsByResourceNameAndTimeRange(ctx context.Context,
 	return res, nil
 }
 
+// IterReadingsByResourceNameAndTimeRange iterates over the readings of ReadingsByResourceNameAndTimeRange, querying pageSize readings at a time
+func (rc readingClient) IterReadingsByResourceNameAndTimeRange(ctx context.Context, name string, start int64, end int64, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.BaseReading, uint32, errors.EdgeX) {
+		res, err := rc.ReadingsByResourceNameAndTimeRange(ctx, name, start, end, offset, limit)
+		return res.Readings, res.TotalCount, err
+	})
+}
+
 func (rc readingClient) ReadingsByDeviceNameAndResourceName(ctx context.Context, deviceName, resourceName string, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
 	requestPath := common.NewPathBuilder().EnableNameFieldEscape(rc.enableNameFieldEscape).
 		SetPath(common.ApiReadingRoute).SetPath(common.Device).SetPath(common.Name).SetNameFieldPath(deviceName).SetPath(common.ResourceName).SetNameFieldPath(resourceName).BuildPath()
@@ -136,6 +178,14 @@ func (rc readingClient) ReadingsByDeviceNameAndResourceName(ctx context.Context,
 
 }
 
+// IterReadingsByDeviceNameAndResourceName iterates over the readings of ReadingsByDeviceNameAndResourceName, querying pageSize readings at a time
+func (rc readingClient) IterReadingsByDeviceNameAndResourceName(ctx context.Context, deviceName string, resourceName string, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.BaseReading, uint32, errors.EdgeX) {
+		res, err := rc.ReadingsByDeviceNameAndResourceName(ctx, deviceName, resourceName, offset, limit)
+		return res.Readings, res.TotalCount, err
+	})
+}
+
 func (rc readingClient) ReadingsByDeviceNameAndResourceNameAndTimeRange(ctx context.Context, deviceName, resourceName string, start, end int64, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
 	requestPath := common.NewPathBuilder().EnableNameFieldEscape(rc.enableNameFieldEscape).
 		SetPath(common.ApiReadingRoute).SetPath(common.Device).SetPath(common.Name).SetNameFieldPath(deviceName).SetPath(common.ResourceName).SetNameFieldPath(resourceName).
@@ -151,6 +201,14 @@ func (rc readingClient) ReadingsByDeviceNameAndResourceNameAndTimeRange(ctx cont
 	return res, nil
 }
 
+// IterReadingsByDeviceNameAndResourceNameAndTimeRange iterates over the readings of ReadingsByDeviceNameAndResourceNameAndTimeRange, querying pageSize readings at a time
+func (rc readingClient) IterReadingsByDeviceNameAndResourceNameAndTimeRange(ctx context.Context, deviceName string, resourceName string, start int64, end int64, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.BaseReading, uint32, errors.EdgeX) {
+		res, err := rc.ReadingsByDeviceNameAndResourceNameAndTimeRange(ctx, deviceName, resourceName, start, end, offset, limit)
+		return res.Readings, res.TotalCount, err
+	})
+}
+
 func (rc readingClient) ReadingsByDeviceNameAndResourceNamesAndTimeRange(ctx context.Context, deviceName string, resourceNames []string, start, end int64, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
 	requestPath := common.NewPathBuilder().EnableNameFieldEscape(rc.enableNameFieldEscape).
 		SetPath(common.ApiReadingRoute).SetPath(common.Device).SetPath(common.Name).SetNameFieldPath(deviceName).
@@ -170,3 +228,11 @@ func (rc readingClient) ReadingsByDeviceNameAndResourceNamesAndTimeRange(ctx con
 	}
 	return res, nil
 }
+
+// IterReadingsByDeviceNameAndResourceNamesAndTimeRange iterates over the readings of ReadingsByDeviceNameAndResourceNamesAndTimeRange, querying pageSize readings at a time
+func (rc readingClient) IterReadingsByDeviceNameAndResourceNamesAndTimeRange(ctx context.Context, deviceName string, resourceNames []string, start int64, end int64, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.BaseReading, uint32, errors.EdgeX) {
+		res, err := rc.ReadingsByDeviceNameAndResourceNamesAndTimeRange(ctx, deviceName, resourceNames, start, end, offset, limit)
+		return res.Readings, res.TotalCount, err
+	})
+}
@@ -0,0 +1,96 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+type RuleClient struct {
+	baseUrl               string
+	authInjector          interfaces.AuthenticationInjector
+	enableNameFieldEscape bool
+}
+
+// NewRuleClient creates an instance of RuleClient
+func NewRuleClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool, options ...ClientOptions) interfaces.RuleClient {
+	return &RuleClient{
+		baseUrl:               baseUrl,
+		authInjector:          utils.NewClientTransport(authInjector, options...),
+		enableNameFieldEscape: enableNameFieldEscape,
+	}
+}
+
+// Add adds a new rule
+func (client *RuleClient) Add(ctx context.Context, rule dtos.Rule) (res dtoCommon.BaseResponse, err errors.EdgeX) {
+	err = utils.PostRequestWithRawData(ctx, &res, client.baseUrl, common.ApiRuleRoute, nil, rule, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// Update updates a rule
+func (client *RuleClient) Update(ctx context.Context, rule dtos.Rule) (res dtoCommon.BaseResponse, err errors.EdgeX) {
+	err = utils.PutRequest(ctx, &res, client.baseUrl, common.ApiRuleRoute, nil, rule, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// AllRules queries the rules with offset, limit
+func (client *RuleClient) AllRules(ctx context.Context, offset int, limit int) (res responses.MultiRulesResponse, err errors.EdgeX) {
+	requestParams := url.Values{}
+	requestParams.Set(common.Offset, strconv.Itoa(offset))
+	requestParams.Set(common.Limit, strconv.Itoa(limit))
+	err = utils.GetRequest(ctx, &res, client.baseUrl, common.ApiAllRulesRoute, requestParams, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// IterAllRules iterates over the rules of AllRules, querying pageSize rules at a time
+func (client *RuleClient) IterAllRules(ctx context.Context, pageSize int) iter.Seq2[dtos.Rule, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Rule, uint32, errors.EdgeX) {
+		res, err := client.AllRules(ctx, offset, limit)
+		return res.Rules, res.TotalCount, err
+	})
+}
+
+// RuleByName queries the rule by name
+func (client *RuleClient) RuleByName(ctx context.Context, name string) (res responses.RuleResponse, err errors.EdgeX) {
+	requestPath := common.NewPathBuilder().EnableNameFieldEscape(client.enableNameFieldEscape).
+		SetPath(common.ApiRuleRoute).SetPath(common.Name).SetNameFieldPath(name).BuildPath()
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, nil, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// DeleteRuleByName deletes the rule by name
+func (client *RuleClient) DeleteRuleByName(ctx context.Context, name string) (res dtoCommon.BaseResponse, err errors.EdgeX) {
+	requestPath := common.NewPathBuilder().EnableNameFieldEscape(client.enableNameFieldEscape).
+		SetPath(common.ApiRuleRoute).SetPath(common.Name).SetNameFieldPath(name).BuildPath()
+	err = utils.DeleteRequest(ctx, &res, client.baseUrl, requestPath, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
@@ -0,0 +1,81 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+)
+
+var testRule = dtos.NewRule(TestRuleName, []byte(`{"sql": "SELECT * FROM edgex"}`))
+
+func TestRuleClient_Add(t *testing.T) {
+	ts := newTestServer(http.MethodPost, common.ApiRuleRoute, dtoCommon.BaseResponse{})
+	defer ts.Close()
+	client := NewRuleClient(ts.URL, NewNullAuthenticationInjector(), false)
+	res, err := client.Add(context.Background(), testRule)
+	require.NoError(t, err)
+	require.IsType(t, dtoCommon.BaseResponse{}, res)
+}
+
+func TestRuleClient_Update(t *testing.T) {
+	ts := newTestServer(http.MethodPut, common.ApiRuleRoute, dtoCommon.BaseResponse{})
+	defer ts.Close()
+	client := NewRuleClient(ts.URL, NewNullAuthenticationInjector(), false)
+	res, err := client.Update(context.Background(), testRule)
+	require.NoError(t, err)
+	require.IsType(t, dtoCommon.BaseResponse{}, res)
+}
+
+func TestRuleClient_AllRules(t *testing.T) {
+	ts := newTestServer(http.MethodGet, common.ApiAllRulesRoute, responses.MultiRulesResponse{})
+	defer ts.Close()
+	client := NewRuleClient(ts.URL, NewNullAuthenticationInjector(), false)
+	res, err := client.AllRules(context.Background(), 0, 10)
+	require.NoError(t, err)
+	require.IsType(t, responses.MultiRulesResponse{}, res)
+}
+
+func TestRuleClient_IterAllRules(t *testing.T) {
+	ts := newTestServer(http.MethodGet, common.ApiAllRulesRoute, responses.NewMultiRulesResponse("", "", http.StatusOK, 1, []dtos.Rule{testRule}))
+	defer ts.Close()
+	client := NewRuleClient(ts.URL, NewNullAuthenticationInjector(), false)
+	var rules []dtos.Rule
+	for rule, err := range client.IterAllRules(context.Background(), 10) {
+		require.NoError(t, err)
+		rules = append(rules, rule)
+	}
+	require.Equal(t, []dtos.Rule{testRule}, rules)
+}
+
+func TestRuleClient_RuleByName(t *testing.T) {
+	requestPath := path.Join(common.ApiRuleRoute, common.Name, TestRuleName)
+	ts := newTestServer(http.MethodGet, requestPath, responses.RuleResponse{})
+	defer ts.Close()
+	client := NewRuleClient(ts.URL, NewNullAuthenticationInjector(), false)
+	res, err := client.RuleByName(context.Background(), TestRuleName)
+	require.NoError(t, err)
+	require.IsType(t, responses.RuleResponse{}, res)
+}
+
+func TestRuleClient_DeleteRuleByName(t *testing.T) {
+	requestPath := path.Join(common.ApiRuleRoute, common.Name, TestRuleName)
+	ts := newTestServer(http.MethodDelete, requestPath, dtoCommon.BaseResponse{})
+	defer ts.Close()
+	client := NewRuleClient(ts.URL, NewNullAuthenticationInjector(), false)
+	res, err := client.DeleteRuleByName(context.Background(), TestRuleName)
+	require.NoError(t, err)
+	require.IsType(t, dtoCommon.BaseResponse{}, res)
+}
@@ -7,6 +7,7 @@ package http
 
 import (
 	"context"
+	"iter"
 	"net/url"
 	"path"
 	"strconv"
@@ -14,6 +15,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 )
@@ -25,10 +27,10 @@ type ScheduleActionRecordClient struct {
 }
 
 // NewScheduleActionRecordClient creates an instance of ScheduleActionRecordClient
-func NewScheduleActionRecordClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool) interfaces.ScheduleActionRecordClient {
+func NewScheduleActionRecordClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool, options ...ClientOptions) interfaces.ScheduleActionRecordClient {
 	return &ScheduleActionRecordClient{
 		baseUrl:               baseUrl,
-		authInjector:          authInjector,
+		authInjector:          utils.NewClientTransport(authInjector, options...),
 		enableNameFieldEscape: enableNameFieldEscape,
 	}
 }
@@ -47,6 +49,14 @@ func (client *ScheduleActionRecordClient) AllScheduleActionRecords(ctx context.C
 	return res, nil
 }
 
+// IterAllScheduleActionRecords iterates over the schedule action records of AllScheduleActionRecords, querying pageSize schedule action records at a time
+func (client *ScheduleActionRecordClient) IterAllScheduleActionRecords(ctx context.Context, start int64, end int64, pageSize int) iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.ScheduleActionRecord, uint32, errors.EdgeX) {
+		res, err := client.AllScheduleActionRecords(ctx, start, end, offset, limit)
+		return res.ScheduleActionRecords, res.TotalCount, err
+	})
+}
+
 // LatestScheduleActionRecordsByJobName query the latest schedule action records by job name
 func (client *ScheduleActionRecordClient) LatestScheduleActionRecordsByJobName(ctx context.Context, jobName string) (res responses.MultiScheduleActionRecordsResponse, err errors.EdgeX) {
 	requestPath := path.Join(common.ApiScheduleActionRecordRoute, common.Latest, common.Job, common.Name, jobName)
@@ -74,6 +84,14 @@ func (client *ScheduleActionRecordClient) ScheduleActionRecordsByStatus(ctx cont
 	return res, nil
 }
 
+// IterScheduleActionRecordsByStatus iterates over the schedule action records of ScheduleActionRecordsByStatus, querying pageSize schedule action records at a time
+func (client *ScheduleActionRecordClient) IterScheduleActionRecordsByStatus(ctx context.Context, status string, start int64, end int64, pageSize int) iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.ScheduleActionRecord, uint32, errors.EdgeX) {
+		res, err := client.ScheduleActionRecordsByStatus(ctx, status, start, end, offset, limit)
+		return res.ScheduleActionRecords, res.TotalCount, err
+	})
+}
+
 // ScheduleActionRecordsByJobName queries schedule action records with jobName, start, end, offset, and limit
 func (client *ScheduleActionRecordClient) ScheduleActionRecordsByJobName(ctx context.Context, jobName string, start, end int64, offset, limit int) (res responses.MultiScheduleActionRecordsResponse, err errors.EdgeX) {
 	requestPath := path.Join(common.ApiScheduleActionRecordRoute, common.Job, common.Name, jobName)
@@ -89,6 +107,14 @@ func (client *ScheduleActionRecordClient) ScheduleActionRecordsByJobName(ctx con
 	return res, nil
 }
 
+// IterScheduleActionRecordsByJobName iterates over the schedule action records of ScheduleActionRecordsByJobName, querying pageSize schedule action records at a time
+func (client *ScheduleActionRecordClient) IterScheduleActionRecordsByJobName(ctx context.Context, jobName string, start int64, end int64, pageSize int) iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.ScheduleActionRecord, uint32, errors.EdgeX) {
+		res, err := client.ScheduleActionRecordsByJobName(ctx, jobName, start, end, offset, limit)
+		return res.ScheduleActionRecords, res.TotalCount, err
+	})
+}
+
 // ScheduleActionRecordsByJobNameAndStatus queries schedule action records with jobName, status, start, end, offset, and limit
 func (client *ScheduleActionRecordClient) ScheduleActionRecordsByJobNameAndStatus(ctx context.Context, jobName, status string, start, end int64, offset, limit int) (res responses.MultiScheduleActionRecordsResponse, err errors.EdgeX) {
 	requestPath := path.Join(common.ApiScheduleActionRecordRoute, common.Job, common.Name, jobName, common.Status, status)
@@ -103,3 +129,11 @@ func (client *ScheduleActionRecordClient) ScheduleActionRecordsByJobNameAndStatu
 	}
 	return res, nil
 }
+
+// IterScheduleActionRecordsByJobNameAndStatus iterates over the schedule action records of ScheduleActionRecordsByJobNameAndStatus, querying pageSize schedule action records at a time
+func (client *ScheduleActionRecordClient) IterScheduleActionRecordsByJobNameAndStatus(ctx context.Context, jobName string, status string, start int64, end int64, pageSize int) iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.ScheduleActionRecord, uint32, errors.EdgeX) {
+		res, err := client.ScheduleActionRecordsByJobNameAndStatus(ctx, jobName, status, start, end, offset, limit)
+		return res.ScheduleActionRecords, res.TotalCount, err
+	})
+}
@@ -7,6 +7,7 @@ package http
 
 import (
 	"context"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
@@ -27,10 +29,10 @@ type ScheduleJobClient struct {
 }
 
 // NewScheduleJobClient creates an instance of ScheduleJobClient
-func NewScheduleJobClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool) interfaces.ScheduleJobClient {
+func NewScheduleJobClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool, options ...ClientOptions) interfaces.ScheduleJobClient {
 	return &ScheduleJobClient{
 		baseUrl:               baseUrl,
-		authInjector:          authInjector,
+		authInjector:          utils.NewClientTransport(authInjector, options...),
 		enableNameFieldEscape: enableNameFieldEscape,
 	}
 }
@@ -71,6 +73,14 @@ func (client ScheduleJobClient) AllScheduleJobs(ctx context.Context, labels []st
 	return res, nil
 }
 
+// IterAllScheduleJobs iterates over the schedule jobs of AllScheduleJobs, querying pageSize schedule jobs at a time
+func (client ScheduleJobClient) IterAllScheduleJobs(ctx context.Context, labels []string, pageSize int) iter.Seq2[dtos.ScheduleJob, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.ScheduleJob, uint32, errors.EdgeX) {
+		res, err := client.AllScheduleJobs(ctx, labels, offset, limit)
+		return res.ScheduleJobs, res.TotalCount, err
+	})
+}
+
 // ScheduleJobByName queries the schedule job by name
 func (client ScheduleJobClient) ScheduleJobByName(ctx context.Context, name string) (
 	res responses.ScheduleJobResponse, err errors.EdgeX) {
@@ -8,6 +8,7 @@ package http
 
 import (
 	"context"
+	"iter"
 	"net/url"
 	"path"
 	"strconv"
@@ -15,6 +16,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
@@ -28,10 +30,10 @@ type SubscriptionClient struct {
 }
 
 // NewSubscriptionClient creates an instance of SubscriptionClient
-func NewSubscriptionClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool) interfaces.SubscriptionClient {
+func NewSubscriptionClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool, options ...ClientOptions) interfaces.SubscriptionClient {
 	return &SubscriptionClient{
 		baseUrl:               baseUrl,
-		authInjector:          authInjector,
+		authInjector:          utils.NewClientTransport(authInjector, options...),
 		enableNameFieldEscape: enableNameFieldEscape,
 	}
 }
@@ -66,6 +68,14 @@ func (client *SubscriptionClient) AllSubscriptions(ctx context.Context, offset i
 	return res, nil
 }
 
+// IterAllSubscriptions iterates over the subscriptions of AllSubscriptions, querying pageSize subscriptions at a time
+func (client *SubscriptionClient) IterAllSubscriptions(ctx context.Context, pageSize int) iter.Seq2[dtos.Subscription, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Subscription, uint32, errors.EdgeX) {
+		res, err := client.AllSubscriptions(ctx, offset, limit)
+		return res.Subscriptions, res.TotalCount, err
+	})
+}
+
 // SubscriptionsByCategory queries subscriptions with category, offset and limit
 func (client *SubscriptionClient) SubscriptionsByCategory(ctx context.Context, category string, offset int, limit int) (res responses.MultiSubscriptionsResponse, err errors.EdgeX) {
 	requestPath := path.Join(common.ApiSubscriptionRoute, common.Category, category)
@@ -79,6 +89,14 @@ func (client *SubscriptionClient) SubscriptionsByCategory(ctx context.Context, c
 	return res, nil
 }
 
+// IterSubscriptionsByCategory iterates over the subscriptions of SubscriptionsByCategory, querying pageSize subscriptions at a time
+func (client *SubscriptionClient) IterSubscriptionsByCategory(ctx context.Context, category string, pageSize int) iter.Seq2[dtos.Subscription, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Subscription, uint32, errors.EdgeX) {
+		res, err := client.SubscriptionsByCategory(ctx, category, offset, limit)
+		return res.Subscriptions, res.TotalCount, err
+	})
+}
+
 // SubscriptionsByLabel queries subscriptions with label, offset and limit
 func (client *SubscriptionClient) SubscriptionsByLabel(ctx context.Context, label string, offset int, limit int) (res responses.MultiSubscriptionsResponse, err errors.EdgeX) {
 	requestPath := path.Join(common.ApiSubscriptionRoute, common.Label, label)
@@ -92,6 +110,14 @@ func (client *SubscriptionClient) SubscriptionsByLabel(ctx context.Context, labe
 	return res, nil
 }
 
+// IterSubscriptionsByLabel iterates over the subscriptions of SubscriptionsByLabel, querying pageSize subscriptions at a time
+func (client *SubscriptionClient) IterSubscriptionsByLabel(ctx context.Context, label string, pageSize int) iter.Seq2[dtos.Subscription, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Subscription, uint32, errors.EdgeX) {
+		res, err := client.SubscriptionsByLabel(ctx, label, offset, limit)
+		return res.Subscriptions, res.TotalCount, err
+	})
+}
+
 // SubscriptionsByReceiver queries subscriptions with receiver, offset and limit
 func (client *SubscriptionClient) SubscriptionsByReceiver(ctx context.Context, receiver string, offset int, limit int) (res responses.MultiSubscriptionsResponse, err errors.EdgeX) {
 	requestPath := path.Join(common.ApiSubscriptionRoute, common.Receiver, receiver)
@@ -105,6 +131,14 @@ func (client *SubscriptionClient) SubscriptionsByReceiver(ctx context.Context, r
 	return res, nil
 }
 
+// IterSubscriptionsByReceiver iterates over the subscriptions of SubscriptionsByReceiver, querying pageSize subscriptions at a time
+func (client *SubscriptionClient) IterSubscriptionsByReceiver(ctx context.Context, receiver string, pageSize int) iter.Seq2[dtos.Subscription, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Subscription, uint32, errors.EdgeX) {
+		res, err := client.SubscriptionsByReceiver(ctx, receiver, offset, limit)
+		return res.Subscriptions, res.TotalCount, err
+	})
+}
+
 // SubscriptionByName query subscription by name.
 func (client *SubscriptionClient) SubscriptionByName(ctx context.Context, name string) (res responses.SubscriptionResponse, err errors.EdgeX) {
 	path := common.NewPathBuilder().EnableNameFieldEscape(client.enableNameFieldEscape).
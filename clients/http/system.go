@@ -23,10 +23,10 @@ type SystemManagementClient struct {
 	authInjector interfaces.AuthenticationInjector
 }
 
-func NewSystemManagementClient(baseUrl string, authInjector interfaces.AuthenticationInjector) interfaces.SystemManagementClient {
+func NewSystemManagementClient(baseUrl string, authInjector interfaces.AuthenticationInjector, options ...ClientOptions) interfaces.SystemManagementClient {
 	return &SystemManagementClient{
 		baseUrl:      baseUrl,
-		authInjector: authInjector,
+		authInjector: utils.NewClientTransport(authInjector, options...),
 	}
 }
 
@@ -8,6 +8,7 @@ package http
 
 import (
 	"context"
+	"iter"
 	"net/url"
 	"path"
 	"strconv"
@@ -15,6 +16,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -27,10 +29,10 @@ type TransmissionClient struct {
 }
 
 // NewTransmissionClient creates an instance of TransmissionClient
-func NewTransmissionClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool) interfaces.TransmissionClient {
+func NewTransmissionClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool, options ...ClientOptions) interfaces.TransmissionClient {
 	return &TransmissionClient{
 		baseUrl:               baseUrl,
-		authInjector:          authInjector,
+		authInjector:          utils.NewClientTransport(authInjector, options...),
 		enableNameFieldEscape: enableNameFieldEscape,
 	}
 }
@@ -58,6 +60,14 @@ func (client *TransmissionClient) TransmissionsByTimeRange(ctx context.Context,
 	return res, nil
 }
 
+// IterTransmissionsByTimeRange iterates over the transmissions of TransmissionsByTimeRange, querying pageSize transmissions at a time
+func (client *TransmissionClient) IterTransmissionsByTimeRange(ctx context.Context, start int64, end int64, pageSize int) iter.Seq2[dtos.Transmission, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Transmission, uint32, errors.EdgeX) {
+		res, err := client.TransmissionsByTimeRange(ctx, start, end, offset, limit)
+		return res.Transmissions, res.TotalCount, err
+	})
+}
+
 // AllTransmissions query transmissions with offset and limit
 func (client *TransmissionClient) AllTransmissions(ctx context.Context, offset int, limit int) (res responses.MultiTransmissionsResponse, err errors.EdgeX) {
 	requestParams := url.Values{}
@@ -70,6 +80,14 @@ func (client *TransmissionClient) AllTransmissions(ctx context.Context, offset i
 	return res, nil
 }
 
+// IterAllTransmissions iterates over the transmissions of AllTransmissions, querying pageSize transmissions at a time
+func (client *TransmissionClient) IterAllTransmissions(ctx context.Context, pageSize int) iter.Seq2[dtos.Transmission, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Transmission, uint32, errors.EdgeX) {
+		res, err := client.AllTransmissions(ctx, offset, limit)
+		return res.Transmissions, res.TotalCount, err
+	})
+}
+
 // TransmissionsByStatus queries transmissions with status, offset and limit
 func (client *TransmissionClient) TransmissionsByStatus(ctx context.Context, status string, offset int, limit int) (res responses.MultiTransmissionsResponse, err errors.EdgeX) {
 	requestPath := path.Join(common.ApiTransmissionRoute, common.Status, status)
@@ -83,6 +101,14 @@ func (client *TransmissionClient) TransmissionsByStatus(ctx context.Context, sta
 	return res, nil
 }
 
+// IterTransmissionsByStatus iterates over the transmissions of TransmissionsByStatus, querying pageSize transmissions at a time
+func (client *TransmissionClient) IterTransmissionsByStatus(ctx context.Context, status string, pageSize int) iter.Seq2[dtos.Transmission, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Transmission, uint32, errors.EdgeX) {
+		res, err := client.TransmissionsByStatus(ctx, status, offset, limit)
+		return res.Transmissions, res.TotalCount, err
+	})
+}
+
 // DeleteProcessedTransmissionsByAge deletes the processed transmissions if the current timestamp minus their created timestamp is less than the age parameter.
 func (client *TransmissionClient) DeleteProcessedTransmissionsByAge(ctx context.Context, age int) (res dtoCommon.BaseResponse, err errors.EdgeX) {
 	path := path.Join(common.ApiTransmissionRoute, common.Age, strconv.Itoa(age))
@@ -106,6 +132,14 @@ func (client *TransmissionClient) TransmissionsBySubscriptionName(ctx context.Co
 	return res, nil
 }
 
+// IterTransmissionsBySubscriptionName iterates over the transmissions of TransmissionsBySubscriptionName, querying pageSize transmissions at a time
+func (client *TransmissionClient) IterTransmissionsBySubscriptionName(ctx context.Context, subscriptionName string, pageSize int) iter.Seq2[dtos.Transmission, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Transmission, uint32, errors.EdgeX) {
+		res, err := client.TransmissionsBySubscriptionName(ctx, subscriptionName, offset, limit)
+		return res.Transmissions, res.TotalCount, err
+	})
+}
+
 // TransmissionsByNotificationId query transmissions with notification id, offset and limit
 func (client *TransmissionClient) TransmissionsByNotificationId(ctx context.Context, id string, offset int, limit int) (res responses.MultiTransmissionsResponse, err errors.EdgeX) {
 	requestPath := path.Join(common.ApiTransmissionRoute, common.Notification, common.Id, id)
@@ -118,3 +152,11 @@ func (client *TransmissionClient) TransmissionsByNotificationId(ctx context.Cont
 	}
 	return res, nil
 }
+
+// IterTransmissionsByNotificationId iterates over the transmissions of TransmissionsByNotificationId, querying pageSize transmissions at a time
+func (client *TransmissionClient) IterTransmissionsByNotificationId(ctx context.Context, id string, pageSize int) iter.Seq2[dtos.Transmission, errors.EdgeX] {
+	return iterPages(ctx, pageSize, func(offset, limit int) ([]dtos.Transmission, uint32, errors.EdgeX) {
+		res, err := client.TransmissionsByNotificationId(ctx, id, offset, limit)
+		return res.Transmissions, res.TotalCount, err
+	})
+}
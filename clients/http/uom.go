@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+type UnitsOfMeasureClient struct {
+	baseUrl      string
+	authInjector interfaces.AuthenticationInjector
+}
+
+// NewUnitsOfMeasureClient creates an instance of UnitsOfMeasureClient
+func NewUnitsOfMeasureClient(baseUrl string, authInjector interfaces.AuthenticationInjector, options ...ClientOptions) interfaces.UnitsOfMeasureClient {
+	return &UnitsOfMeasureClient{
+		baseUrl:      baseUrl,
+		authInjector: utils.NewClientTransport(authInjector, options...),
+	}
+}
+
+// UnitsOfMeasure queries the units of measure
+func (client *UnitsOfMeasureClient) UnitsOfMeasure(ctx context.Context) (res responses.UnitsOfMeasureResponse, err errors.EdgeX) {
+	err = utils.GetRequest(ctx, &res, client.baseUrl, common.ApiUnitsOfMeasureRoute, nil, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
@@ -0,0 +1,26 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+)
+
+func TestUnitsOfMeasureClient_UnitsOfMeasure(t *testing.T) {
+	ts := newTestServer(http.MethodGet, common.ApiUnitsOfMeasureRoute, responses.UnitsOfMeasureResponse{})
+	defer ts.Close()
+	client := NewUnitsOfMeasureClient(ts.URL, NewNullAuthenticationInjector())
+	res, err := client.UnitsOfMeasure(context.Background())
+	require.NoError(t, err)
+	require.IsType(t, responses.UnitsOfMeasureResponse{}, res)
+}
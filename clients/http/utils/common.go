@@ -55,24 +55,14 @@ func getBody(resp *http.Response) ([]byte, errors.EdgeX) {
 	return body, nil
 }
 
-// Helper method to make the request and return the response
+// Helper method to make the request and return the response, the authInjector may be nil or the one returned by
+// NewClientTransport
 func makeRequest(req *http.Request, authInjector interfaces.AuthenticationInjector) (*http.Response, errors.EdgeX) {
-	if authInjector != nil {
-		if err := authInjector.AddAuthenticationData(req); err != nil {
-			return nil, errors.NewCommonEdgeXWrapper(err)
-		}
-	}
-
-	client := &http.Client{Transport: authInjector.RoundTripper()}
-
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "failed to send a http request", err)
-	}
-	if resp == nil {
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, "the response should not be a nil", nil)
+	transport, ok := authInjector.(*clientTransport)
+	if !ok {
+		transport = &clientTransport{authInjector: authInjector}
 	}
-	return resp, nil
+	return transport.do(req)
 }
 
 func createRequest(ctx context.Context, httpMethod string, baseUrl string, requestPath string, requestParams url.Values) (*http.Request, errors.EdgeX) {
@@ -83,7 +73,7 @@ func createRequest(ctx context.Context, httpMethod string, baseUrl string, reque
 	if requestParams != nil {
 		u.RawQuery = requestParams.Encode()
 	}
-	req, err := http.NewRequest(httpMethod, u.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, httpMethod, u.String(), nil)
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create a http request", err)
 	}
@@ -110,7 +100,7 @@ func createRequestWithRawDataAndParams(ctx context.Context, httpMethod string, b
 		content = common.ContentTypeJSON
 	}
 
-	req, err := http.NewRequest(httpMethod, u.String(), bytes.NewReader(jsonEncodedData))
+	req, err := http.NewRequestWithContext(ctx, httpMethod, u.String(), bytes.NewReader(jsonEncodedData))
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create a http request", err)
 	}
@@ -138,7 +128,7 @@ func createRequestWithRawData(ctx context.Context, httpMethod string, baseUrl st
 		content = common.ContentTypeJSON
 	}
 
-	req, err := http.NewRequest(httpMethod, u.String(), bytes.NewReader(jsonEncodedData))
+	req, err := http.NewRequestWithContext(ctx, httpMethod, u.String(), bytes.NewReader(jsonEncodedData))
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create a http request", err)
 	}
@@ -158,7 +148,7 @@ func createRequestWithEncodedData(ctx context.Context, httpMethod string, baseUr
 		content = FromContext(ctx, common.ContentType)
 	}
 
-	req, err := http.NewRequest(httpMethod, u.String(), bytes.NewReader(data))
+	req, err := http.NewRequestWithContext(ctx, httpMethod, u.String(), bytes.NewReader(data))
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create a http request", err)
 	}
@@ -191,7 +181,7 @@ func createRequestFromFilePath(ctx context.Context, httpMethod string, baseUrl s
 	}
 	writer.Close()
 
-	req, err := http.NewRequest(httpMethod, u.String(), body)
+	req, err := http.NewRequestWithContext(ctx, httpMethod, u.String(), body)
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create a http request", err)
 	}
@@ -0,0 +1,240 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// defaultRetryOn are the error kinds retried by a RetryPolicy without RetryOn, and the failures counted by
+// CircuitBreakers without FailureOn
+var defaultRetryOn = []errors.ErrKind{errors.KindServiceUnavailable, errors.KindCommunicationError}
+
+// idempotentMethods are the HTTP methods which are retried unless RetryNonIdempotent is set
+var idempotentMethods = []string{http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete}
+
+// RetryPolicy retries the failed requests with exponential backoff and jitter
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts including the first one, the requests are not retried if it is less than 2
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait before each retry, no cap if zero
+	MaxBackoff time.Duration
+	// Multiplier grows the wait after every retry, the wait is constant if it is less than 1
+	Multiplier float64
+	// Jitter is the fraction, from 0 to 1, of each wait which is randomly cut so that the clients do not retry in step
+	Jitter float64
+	// RetryOn are the error kinds which are retried, KindServiceUnavailable and KindCommunicationError if empty
+	RetryOn []errors.ErrKind
+	// RetryNonIdempotent also retries the POST and PATCH requests, which may otherwise be applied twice, e.g. an event
+	// which is added again
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy of 3 attempts, which waits 100ms, then 200ms, with 20% jitter
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+// shouldRetry returns whether the request which failed with the error kind on the attempt is retried
+func (p *RetryPolicy) shouldRetry(req *http.Request, attempt int, kind errors.ErrKind) bool {
+	if p == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+	retryOn := p.RetryOn
+	if len(retryOn) == 0 {
+		retryOn = defaultRetryOn
+	}
+	if !slices.Contains(retryOn, kind) {
+		return false
+	}
+	if !p.RetryNonIdempotent && !slices.Contains(idempotentMethods, req.Method) {
+		return false
+	}
+	// the body is sent again, so it must be rewindable
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// backoff returns the wait before the retry following the attempt
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := float64(p.InitialBackoff)
+	if p.Multiplier > 1 {
+		backoff *= math.Pow(p.Multiplier, float64(attempt-1))
+	}
+	if p.MaxBackoff > 0 {
+		backoff = math.Min(backoff, float64(p.MaxBackoff))
+	}
+	jitter := math.Max(0, math.Min(p.Jitter, 1))
+	return time.Duration(backoff - backoff*jitter*rand.Float64())
+}
+
+// wait blocks for the backoff of the attempt, or until the context is done
+func (p *RetryPolicy) wait(ctx context.Context, attempt int) error {
+	timer := time.NewTimer(p.backoff(attempt))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// CircuitState is the state of the circuit breaker of a base URL
+type CircuitState string
+
+const (
+	// CircuitClosed lets the requests through
+	CircuitClosed CircuitState = "closed"
+	// CircuitOpen rejects the requests without sending them
+	CircuitOpen CircuitState = "open"
+	// CircuitHalfOpen lets a single trial request through, which closes the circuit if it succeeds or opens it again
+	CircuitHalfOpen CircuitState = "half-open"
+)
+
+// CircuitBreakerPolicy configures the CircuitBreakers
+type CircuitBreakerPolicy struct {
+	// FailureThreshold is the number of consecutive failures which opens the circuit, 5 if zero
+	FailureThreshold int
+	// OpenTimeout is how long the circuit stays open before a trial request is let through, 30s if zero
+	OpenTimeout time.Duration
+	// FailureOn are the error kinds counted as failures, KindServiceUnavailable and KindCommunicationError if empty
+	FailureOn []errors.ErrKind
+	// OnStateChange is called with the scheme and host of the base URL when its circuit changes state
+	OnStateChange func(baseUrl string, from, to CircuitState)
+}
+
+// CircuitBreakers keeps a circuit breaker per base URL, i.e. per scheme and host, and can be shared by the clients
+// through ClientOptions so that all the clients of a service stop calling it while it is down
+type CircuitBreakers struct {
+	policy   CircuitBreakerPolicy
+	mutex    sync.Mutex
+	breakers map[string]*circuitBreaker
+	// now returns the current time, it is replaced by the tests to move the clock forward
+	now func() time.Time
+}
+
+type circuitBreaker struct {
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	// trial is set while the trial request of the half-open circuit is in flight
+	trial bool
+}
+
+// NewCircuitBreakers creates an instance of CircuitBreakers
+func NewCircuitBreakers(policy CircuitBreakerPolicy) *CircuitBreakers {
+	if policy.FailureThreshold <= 0 {
+		policy.FailureThreshold = 5
+	}
+	if policy.OpenTimeout <= 0 {
+		policy.OpenTimeout = 30 * time.Second
+	}
+	if len(policy.FailureOn) == 0 {
+		policy.FailureOn = defaultRetryOn
+	}
+	return &CircuitBreakers{policy: policy, breakers: make(map[string]*circuitBreaker), now: time.Now}
+}
+
+// State returns the state of the circuit of the base URL
+func (cbs *CircuitBreakers) State(baseUrl string) CircuitState {
+	u, err := url.Parse(baseUrl)
+	if err != nil {
+		return CircuitClosed
+	}
+	cbs.mutex.Lock()
+	defer cbs.mutex.Unlock()
+	breaker, ok := cbs.breakers[circuitKey(u)]
+	if !ok {
+		return CircuitClosed
+	}
+	if breaker.state == CircuitOpen && cbs.now().Sub(breaker.openedAt) >= cbs.policy.OpenTimeout {
+		return CircuitHalfOpen
+	}
+	return breaker.state
+}
+
+func circuitKey(u *url.URL) string {
+	return u.Scheme + "://" + u.Host
+}
+
+// allow returns an error if the circuit of the request URL rejects the request
+func (cbs *CircuitBreakers) allow(u *url.URL) errors.EdgeX {
+	key := circuitKey(u)
+	cbs.mutex.Lock()
+	breaker, ok := cbs.breakers[key]
+	if !ok {
+		breaker = &circuitBreaker{state: CircuitClosed}
+		cbs.breakers[key] = breaker
+	}
+	from := breaker.state
+	if breaker.state == CircuitOpen && cbs.now().Sub(breaker.openedAt) >= cbs.policy.OpenTimeout {
+		breaker.state = CircuitHalfOpen
+	}
+	rejected := breaker.state == CircuitOpen || (breaker.state == CircuitHalfOpen && breaker.trial)
+	if breaker.state == CircuitHalfOpen && !rejected {
+		breaker.trial = true
+	}
+	to := breaker.state
+	cbs.mutex.Unlock()
+
+	cbs.stateChanged(key, from, to)
+	if rejected {
+		return errors.NewCommonEdgeX(errors.KindServiceUnavailable, fmt.Sprintf("the circuit of %s is %s", key, to), nil)
+	}
+	return nil
+}
+
+// record counts the result of the request which was let through by allow
+func (cbs *CircuitBreakers) record(u *url.URL, kind errors.ErrKind, failed bool) {
+	key := circuitKey(u)
+	failed = failed && slices.Contains(cbs.policy.FailureOn, kind)
+	cbs.mutex.Lock()
+	breaker := cbs.breakers[key]
+	from := breaker.state
+	breaker.trial = false
+	switch {
+	case !failed:
+		breaker.state = CircuitClosed
+		breaker.failures = 0
+	case breaker.state == CircuitHalfOpen:
+		breaker.state = CircuitOpen
+		breaker.openedAt = cbs.now()
+	default:
+		breaker.failures++
+		if breaker.failures >= cbs.policy.FailureThreshold {
+			breaker.state = CircuitOpen
+			breaker.openedAt = cbs.now()
+		}
+	}
+	to := breaker.state
+	cbs.mutex.Unlock()
+
+	cbs.stateChanged(key, from, to)
+}
+
+func (cbs *CircuitBreakers) stateChanged(key string, from, to CircuitState) {
+	if from != to && cbs.policy.OnStateChange != nil {
+		cbs.policy.OnStateChange(key, from, to)
+	}
+}
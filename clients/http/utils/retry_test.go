@@ -0,0 +1,210 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFailingServer returns a server which responds with the status code to the first failures requests, and with 200
+// afterward, along with the number of requests it received
+func newFailingServer(t *testing.T, failures int32, statusCode int) (*httptest.Server, *atomic.Int32) {
+	var count atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		if r.Method == http.MethodPost {
+			assert.Equal(t, `{"id":"1"}`, string(body))
+		}
+		if count.Add(1) <= failures {
+			w.WriteHeader(statusCode)
+			return
+		}
+		_, _ = w.Write([]byte("{}"))
+	}))
+	return ts, &count
+}
+
+func TestRetryPolicy(t *testing.T) {
+	retry := &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, Multiplier: 2, Jitter: 0.5}
+	tests := []struct {
+		name             string
+		method           string
+		retry            *RetryPolicy
+		failures         int32
+		statusCode       int
+		expectedRequests int32
+		errorExpected    bool
+	}{
+		{"GET retried until success", http.MethodGet, retry, 2, http.StatusServiceUnavailable, 3, false},
+		{"GET retried until max attempts", http.MethodGet, retry, 5, http.StatusServiceUnavailable, 3, true},
+		{"GET not retried on an unlisted error kind", http.MethodGet, retry, 1, http.StatusNotFound, 1, true},
+		{"GET not retried without policy", http.MethodGet, nil, 1, http.StatusServiceUnavailable, 1, true},
+		{"PUT retried", http.MethodPut, retry, 1, http.StatusBadGateway, 2, false},
+		{"DELETE retried", http.MethodDelete, retry, 1, http.StatusServiceUnavailable, 2, false},
+		{"POST not retried", http.MethodPost, retry, 1, http.StatusServiceUnavailable, 1, true},
+		{"POST retried if non-idempotent retry is enabled", http.MethodPost,
+			&RetryPolicy{MaxAttempts: 2, RetryNonIdempotent: true}, 1, http.StatusServiceUnavailable, 2, false},
+		{"GET retried on the listed error kind", http.MethodGet,
+			&RetryPolicy{MaxAttempts: 2, RetryOn: []errors.ErrKind{errors.KindServerError}}, 1, http.StatusInternalServerError, 2, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			ts, count := newFailingServer(t, testCase.failures, testCase.statusCode)
+			defer ts.Close()
+
+			authInjector := NewClientTransport(nil, ClientOptions{Retry: testCase.retry})
+			var res map[string]any
+			var err errors.EdgeX
+			switch testCase.method {
+			case http.MethodGet:
+				err = GetRequest(context.Background(), &res, ts.URL, "test-path", nil, authInjector)
+			case http.MethodPut:
+				err = PutRequest(context.Background(), &res, ts.URL, "test-path", nil, map[string]string{"id": "1"}, authInjector)
+			case http.MethodDelete:
+				err = DeleteRequest(context.Background(), &res, ts.URL, "test-path", authInjector)
+			case http.MethodPost:
+				err = PostRequest(context.Background(), &res, ts.URL, "test-path", []byte(`{"id":"1"}`), common.ContentTypeJSON, authInjector)
+			}
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindMapping(testCase.statusCode), errors.Kind(err))
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.expectedRequests, count.Load())
+		})
+	}
+}
+
+func TestRetryPolicy_canceled(t *testing.T) {
+	ts, count := newFailingServer(t, 5, http.StatusServiceUnavailable)
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	authInjector := NewClientTransport(nil, ClientOptions{Retry: &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Minute}})
+	var res map[string]any
+	err := GetRequest(ctx, &res, ts.URL, "test-path", nil, authInjector)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+	assert.EqualValues(t, 1, count.Load())
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond, Multiplier: 2}
+	assert.Equal(t, 100*time.Millisecond, policy.backoff(1))
+	assert.Equal(t, 200*time.Millisecond, policy.backoff(2))
+	assert.Equal(t, 300*time.Millisecond, policy.backoff(3))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 10; i++ {
+		backoff := policy.backoff(2)
+		assert.GreaterOrEqual(t, backoff, 100*time.Millisecond)
+		assert.LessOrEqual(t, backoff, 200*time.Millisecond)
+	}
+}
+
+// fakeClock is the clock of the CircuitBreakers in the tests, which only moves forward when advanced so that the open
+// timeout does not depend on how long the requests take
+type fakeClock struct {
+	mutex sync.Mutex
+	now   time.Time
+}
+
+func newFakeClock(breakers *CircuitBreakers) *fakeClock {
+	clock := &fakeClock{now: time.Now()}
+	breakers.now = clock.Now
+	return clock
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestCircuitBreakers(t *testing.T) {
+	ts, count := newFailingServer(t, 2, http.StatusServiceUnavailable)
+	defer ts.Close()
+
+	var transitions []CircuitState
+	breakers := NewCircuitBreakers(CircuitBreakerPolicy{
+		FailureThreshold: 2,
+		OpenTimeout:      time.Minute,
+		OnStateChange: func(baseUrl string, from, to CircuitState) {
+			assert.Equal(t, ts.URL, baseUrl)
+			transitions = append(transitions, to)
+		},
+	})
+	clock := newFakeClock(breakers)
+	authInjector := NewClientTransport(nil, ClientOptions{CircuitBreakers: breakers})
+	baseUrl := ts.URL + "/api/v3"
+	var res map[string]any
+
+	assert.Equal(t, CircuitClosed, breakers.State(baseUrl))
+	for i := 0; i < 2; i++ {
+		require.Error(t, GetRequest(context.Background(), &res, baseUrl, "test-path", nil, authInjector))
+	}
+	assert.Equal(t, CircuitOpen, breakers.State(baseUrl))
+
+	err := GetRequest(context.Background(), &res, baseUrl, "test-path", nil, authInjector)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+	assert.EqualValues(t, 2, count.Load(), "the open circuit should not let the request through")
+
+	clock.Advance(time.Minute - time.Second)
+	assert.Equal(t, CircuitOpen, breakers.State(baseUrl))
+	clock.Advance(time.Second)
+	assert.Equal(t, CircuitHalfOpen, breakers.State(baseUrl))
+	require.NoError(t, GetRequest(context.Background(), &res, baseUrl, "test-path", nil, authInjector))
+	assert.Equal(t, CircuitClosed, breakers.State(baseUrl))
+	assert.Equal(t, []CircuitState{CircuitOpen, CircuitHalfOpen, CircuitClosed}, transitions)
+}
+
+func TestCircuitBreakers_halfOpenFailure(t *testing.T) {
+	ts, _ := newFailingServer(t, 5, http.StatusServiceUnavailable)
+	defer ts.Close()
+
+	breakers := NewCircuitBreakers(CircuitBreakerPolicy{FailureThreshold: 1, OpenTimeout: time.Minute})
+	clock := newFakeClock(breakers)
+	authInjector := NewClientTransport(nil, ClientOptions{CircuitBreakers: breakers})
+	var res map[string]any
+
+	require.Error(t, GetRequest(context.Background(), &res, ts.URL, "test-path", nil, authInjector))
+	assert.Equal(t, CircuitOpen, breakers.State(ts.URL))
+	clock.Advance(time.Minute)
+	assert.Equal(t, CircuitHalfOpen, breakers.State(ts.URL))
+	require.Error(t, GetRequest(context.Background(), &res, ts.URL, "test-path", nil, authInjector))
+	assert.Equal(t, CircuitOpen, breakers.State(ts.URL), "the failed trial request should open the circuit again")
+
+	// the not-found response is not a failure of the service, so it does not open the circuit
+	other, _ := newFailingServer(t, 5, http.StatusNotFound)
+	defer other.Close()
+	for i := 0; i < 3; i++ {
+		require.Error(t, GetRequest(context.Background(), &res, other.URL, "test-path", nil, authInjector))
+	}
+	assert.Equal(t, CircuitClosed, breakers.State(other.URL))
+}
@@ -0,0 +1,242 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"io"
+	"maps"
+	"net/http"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// RequestHook is called with every request before it is sent, the request is aborted if the hook returns an error
+type RequestHook func(req *http.Request) error
+
+// ResponseHook is called after every request with the response, or with the error if the request failed
+type ResponseHook func(req *http.Request, resp *http.Response, err error)
+
+// ClientOptions configures how the clients send the HTTP requests
+type ClientOptions struct {
+	// HTTPClient is shared by all the requests so that the connections are reused. If its Transport is set, it
+	// replaces the RoundTripper of the AuthenticationInjector, e.g. the zero-trust RoundTripper of the secure
+	// AuthenticationInjector is not used, so the Transport must then dial the service itself. If its Transport is nil,
+	// the requests are sent with the RoundTripper of the AuthenticationInjector, or with http.DefaultTransport if there
+	// is none. If HTTPClient is nil, a client with the RoundTripper of the AuthenticationInjector is used.
+	HTTPClient *http.Client
+	// Timeout bounds every request whose context has no deadline, including the reading of the response body
+	Timeout time.Duration
+	// UserAgent is the User-Agent header of every request
+	UserAgent string
+	// Headers are added to every request, unless the request already sets them
+	Headers map[string]string
+	// RequestHooks are called in order with every attempt of a request after the headers and authentication data are
+	// added
+	RequestHooks []RequestHook
+	// ResponseHooks are called in order after every attempt of a request
+	ResponseHooks []ResponseHook
+	// Retry retries the failed requests, the requests are not retried if nil
+	Retry *RetryPolicy
+	// CircuitBreakers rejects the requests to the base URLs which keep failing, nothing is rejected if nil
+	CircuitBreakers *CircuitBreakers
+}
+
+// merge returns the options overridden by the non-zero fields of the other options, the headers are merged and the
+// hooks are appended
+func (o ClientOptions) merge(other ClientOptions) ClientOptions {
+	if other.HTTPClient != nil {
+		o.HTTPClient = other.HTTPClient
+	}
+	if other.Timeout > 0 {
+		o.Timeout = other.Timeout
+	}
+	if other.UserAgent != "" {
+		o.UserAgent = other.UserAgent
+	}
+	if len(other.Headers) > 0 {
+		headers := maps.Clone(o.Headers)
+		if headers == nil {
+			headers = make(map[string]string, len(other.Headers))
+		}
+		maps.Copy(headers, other.Headers)
+		o.Headers = headers
+	}
+	if other.Retry != nil {
+		o.Retry = other.Retry
+	}
+	if other.CircuitBreakers != nil {
+		o.CircuitBreakers = other.CircuitBreakers
+	}
+	o.RequestHooks = append(o.RequestHooks[:len(o.RequestHooks):len(o.RequestHooks)], other.RequestHooks...)
+	o.ResponseHooks = append(o.ResponseHooks[:len(o.ResponseHooks):len(o.ResponseHooks)], other.ResponseHooks...)
+	return o
+}
+
+// clientTransport is the AuthenticationInjector returned by NewClientTransport, which sends the requests of the
+// request functions of this package with the ClientOptions
+type clientTransport struct {
+	authInjector interfaces.AuthenticationInjector
+	options      ClientOptions
+}
+
+// NewClientTransport wraps the authInjector, which may be nil, with the options merged in order. The returned
+// AuthenticationInjector is passed to the request functions of this package in place of the authInjector.
+func NewClientTransport(authInjector interfaces.AuthenticationInjector, options ...ClientOptions) interfaces.AuthenticationInjector {
+	transport := &clientTransport{authInjector: authInjector}
+	if wrapped, ok := authInjector.(*clientTransport); ok {
+		transport = &clientTransport{authInjector: wrapped.authInjector, options: wrapped.options}
+	}
+	for _, o := range options {
+		transport.options = transport.options.merge(o)
+	}
+	return transport
+}
+
+// AddAuthenticationData adds the authentication data of the wrapped AuthenticationInjector, if any
+func (t *clientTransport) AddAuthenticationData(req *http.Request) error {
+	if t.authInjector == nil {
+		return nil
+	}
+	return t.authInjector.AddAuthenticationData(req)
+}
+
+// RoundTripper returns the Transport of the shared HTTPClient if set, or the RoundTripper of the wrapped
+// AuthenticationInjector
+func (t *clientTransport) RoundTripper() http.RoundTripper {
+	if t.options.HTTPClient != nil && t.options.HTTPClient.Transport != nil {
+		return t.options.HTTPClient.Transport
+	}
+	if t.authInjector == nil {
+		return nil
+	}
+	return t.authInjector.RoundTripper()
+}
+
+func (t *clientTransport) httpClient() *http.Client {
+	if t.options.HTTPClient == nil {
+		return &http.Client{Transport: t.RoundTripper()}
+	}
+	if t.options.HTTPClient.Transport != nil {
+		return t.options.HTTPClient
+	}
+	// the shared HTTPClient without Transport sends the requests with the RoundTripper of the AuthenticationInjector
+	client := *t.options.HTTPClient
+	client.Transport = t.RoundTripper()
+	return &client
+}
+
+// do sends the request with the options and the authentication data, and retries it with the RetryPolicy
+func (t *clientTransport) do(req *http.Request) (*http.Response, errors.EdgeX) {
+	if t.options.UserAgent != "" {
+		req.Header.Set("User-Agent", t.options.UserAgent)
+	}
+	for key, value := range t.options.Headers {
+		if req.Header.Get(key) == "" {
+			req.Header.Set(key, value)
+		}
+	}
+	if err := t.AddAuthenticationData(req); err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err, sent := t.attempt(req)
+		if !sent {
+			return nil, err
+		}
+		var kind errors.ErrKind
+		switch {
+		case err != nil:
+			kind = errors.Kind(err)
+		case resp.StatusCode > http.StatusMultiStatus:
+			kind = errors.KindMapping(resp.StatusCode)
+		default:
+			return resp, nil
+		}
+		if !t.options.Retry.shouldRetry(req, attempt, kind) {
+			return resp, err
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		if waitErr := t.options.Retry.wait(req.Context(), attempt); waitErr != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "the request is canceled while waiting to retry", waitErr)
+		}
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to rewind the request body to retry", bodyErr)
+			}
+			req.Body = body
+		}
+	}
+}
+
+// attempt sends the request once through the circuit breaker, sent is false if the request is rejected by the circuit
+// breaker or a request hook
+func (t *clientTransport) attempt(req *http.Request) (resp *http.Response, edgexErr errors.EdgeX, sent bool) {
+	for _, hook := range t.options.RequestHooks {
+		if err := hook(req); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(err), "the request is aborted by the request hook", err), false
+		}
+	}
+	if t.options.CircuitBreakers != nil {
+		if err := t.options.CircuitBreakers.allow(req.URL); err != nil {
+			return nil, err, false
+		}
+	}
+
+	var cancel context.CancelFunc
+	if _, ok := req.Context().Deadline(); !ok && t.options.Timeout > 0 {
+		var ctx context.Context
+		ctx, cancel = context.WithTimeout(req.Context(), t.options.Timeout)
+		req = req.WithContext(ctx)
+	}
+
+	resp, err := t.httpClient().Do(req)
+	for _, hook := range t.options.ResponseHooks {
+		hook(req, resp, err)
+	}
+	switch {
+	case err != nil:
+		edgexErr = errors.NewCommonEdgeX(errors.KindServiceUnavailable, "failed to send a http request", err)
+	case resp == nil:
+		edgexErr = errors.NewCommonEdgeX(errors.KindServerError, "the response should not be a nil", nil)
+	}
+	if t.options.CircuitBreakers != nil {
+		kind := errors.Kind(edgexErr)
+		if edgexErr == nil {
+			kind = errors.KindMapping(resp.StatusCode)
+		}
+		t.options.CircuitBreakers.record(req.URL, kind, edgexErr != nil || resp.StatusCode > http.StatusMultiStatus)
+	}
+	if edgexErr != nil {
+		if cancel != nil {
+			cancel()
+		}
+		return nil, edgexErr, true
+	}
+	if cancel != nil {
+		// the timeout also bounds the reading of the body, so the context is canceled when the body is closed
+		resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	}
+	return resp, nil, true
+}
+
+// cancelOnCloseBody cancels the context of the request when the response body is closed
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
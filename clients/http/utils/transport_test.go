@@ -0,0 +1,150 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	goErrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingRoundTripper struct {
+	count atomic.Int32
+}
+
+func (rt *countingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.count.Add(1)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClientTransport(t *testing.T) {
+	var received http.Header
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer ts.Close()
+
+	roundTripper := &countingRoundTripper{}
+	var requests, responses int
+	authInjector := NewClientTransport(nil,
+		ClientOptions{UserAgent: "agent/1", Headers: map[string]string{"X-Site": "a", "X-Zone": "z"}},
+		ClientOptions{
+			HTTPClient:    &http.Client{Transport: roundTripper},
+			Timeout:       time.Second,
+			UserAgent:     "agent/2",
+			Headers:       map[string]string{"X-Site": "b", common.CorrelationHeader: "overridden"},
+			RequestHooks:  []RequestHook{func(*http.Request) error { requests++; return nil }},
+			ResponseHooks: []ResponseHook{func(_ *http.Request, resp *http.Response, err error) { responses++ }},
+		})
+
+	ctx := context.WithValue(context.Background(), common.CorrelationHeader, "correlation-id") //nolint: staticcheck
+	var res map[string]any
+	err := GetRequest(ctx, &res, ts.URL, "test-path", nil, authInjector)
+	require.NoError(t, err)
+	err = GetRequest(ctx, &res, ts.URL, "test-path", nil, authInjector)
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 2, roundTripper.count.Load())
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 2, responses)
+	assert.Equal(t, "agent/2", received.Get("User-Agent"))
+	assert.Equal(t, "b", received.Get("X-Site"))
+	assert.Equal(t, "z", received.Get("X-Zone"))
+	assert.Equal(t, "correlation-id", received.Get(common.CorrelationHeader))
+}
+
+// roundTripperInjector is an AuthenticationInjector which sends the requests with its RoundTripper, like the secure
+// AuthenticationInjector sends them through the zero-trust network
+type roundTripperInjector struct {
+	roundTripper http.RoundTripper
+}
+
+func (i *roundTripperInjector) AddAuthenticationData(_ *http.Request) error {
+	return nil
+}
+
+func (i *roundTripperInjector) RoundTripper() http.RoundTripper {
+	return i.roundTripper
+}
+
+func TestClientTransport_HTTPClientTransport(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer ts.Close()
+
+	injectorRoundTripper, clientRoundTripper := &countingRoundTripper{}, &countingRoundTripper{}
+	injector := &roundTripperInjector{roundTripper: injectorRoundTripper}
+	var res map[string]any
+
+	withoutTransport := NewClientTransport(injector, ClientOptions{HTTPClient: &http.Client{Timeout: time.Second}})
+	require.NoError(t, GetRequest(context.Background(), &res, ts.URL, "test-path", nil, withoutTransport))
+	assert.EqualValues(t, 1, injectorRoundTripper.count.Load(), "the HTTPClient without Transport should send the requests with the RoundTripper of the AuthenticationInjector")
+
+	withTransport := NewClientTransport(injector, ClientOptions{HTTPClient: &http.Client{Transport: clientRoundTripper}})
+	require.NoError(t, GetRequest(context.Background(), &res, ts.URL, "test-path", nil, withTransport))
+	assert.EqualValues(t, 1, injectorRoundTripper.count.Load())
+	assert.EqualValues(t, 1, clientRoundTripper.count.Load(), "the Transport of the HTTPClient should replace the RoundTripper of the AuthenticationInjector")
+}
+
+func TestClientTransport_nilAuthInjector(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer ts.Close()
+
+	var res map[string]any
+	assert.NoError(t, GetRequest(context.Background(), &res, ts.URL, "test-path", nil, nil))
+	assert.NoError(t, GetRequest(context.Background(), &res, ts.URL, "test-path", nil, NewClientTransport(nil)))
+}
+
+func TestClientTransport_requestHookError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the request should not be sent")
+	}))
+	defer ts.Close()
+
+	authInjector := NewClientTransport(nil, ClientOptions{RequestHooks: []RequestHook{
+		func(*http.Request) error { return errors.NewCommonEdgeX(errors.KindLimitExceeded, "rate limited", nil) },
+	}})
+	var res map[string]any
+	err := GetRequest(context.Background(), &res, ts.URL, "test-path", nil, authInjector)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindLimitExceeded, errors.Kind(err))
+}
+
+func TestClientTransport_timeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer ts.Close()
+
+	var hookErr error
+	authInjector := NewClientTransport(nil, ClientOptions{
+		Timeout:       10 * time.Millisecond,
+		ResponseHooks: []ResponseHook{func(_ *http.Request, _ *http.Response, err error) { hookErr = err }},
+	})
+	var res map[string]any
+	err := GetRequest(context.Background(), &res, ts.URL, "test-path", nil, authInjector)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+	assert.True(t, goErrors.Is(hookErr, context.DeadlineExceeded))
+}
@@ -7,7 +7,9 @@ package interfaces
 
 import (
 	"context"
+	"iter"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -20,6 +22,9 @@ type CommandClient interface {
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	AllDeviceCoreCommands(ctx context.Context, offset int, limit int) (responses.MultiDeviceCoreCommandsResponse, errors.EdgeX)
+	// IterAllDeviceCoreCommands iterates over the device core commands of AllDeviceCoreCommands, querying pageSize device core commands at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterAllDeviceCoreCommands(ctx context.Context, pageSize int) iter.Seq2[dtos.DeviceCoreCommand, errors.EdgeX]
 	// DeviceCoreCommandsByDeviceName returns all commands associated with the specified device name.
 	DeviceCoreCommandsByDeviceName(ctx context.Context, deviceName string) (responses.DeviceCoreCommandResponse, errors.EdgeX)
 	// IssueGetCommandByName issues the specified read command referenced by the command name to the device/sensor that is also referenced by name.
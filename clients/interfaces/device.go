@@ -7,7 +7,9 @@ package interfaces
 
 import (
 	"context"
+	"iter"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
@@ -29,6 +31,9 @@ type DeviceClient interface {
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	AllDevices(ctx context.Context, labels []string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX)
+	// IterAllDevices iterates over the devices of AllDevices, querying pageSize devices at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterAllDevices(ctx context.Context, labels []string, pageSize int) iter.Seq2[dtos.Device, errors.EdgeX]
 	// AllDevicesWithChildren returns all devices who have parent, grandparent, etc. of the
 	// given device name. Devices can also be filtered by labels.
 	// Device tree is descended at most maxLevels. If maxLevels is 0, there is no limit.
@@ -36,6 +41,9 @@ type DeviceClient interface {
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	AllDevicesWithChildren(ctx context.Context, parent string, maxLevels uint, labels []string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX)
+	// IterAllDevicesWithChildren iterates over the devices of AllDevicesWithChildren, querying pageSize devices at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterAllDevicesWithChildren(ctx context.Context, parent string, maxLevels uint, labels []string, pageSize int) iter.Seq2[dtos.Device, errors.EdgeX]
 	// DeviceNameExists checks whether the device exists.
 	DeviceNameExists(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX)
 	// DeviceByName returns a device by device name.
@@ -47,9 +55,15 @@ type DeviceClient interface {
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	DevicesByProfileName(ctx context.Context, name string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX)
+	// IterDevicesByProfileName iterates over the devices of DevicesByProfileName, querying pageSize devices at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterDevicesByProfileName(ctx context.Context, name string, pageSize int) iter.Seq2[dtos.Device, errors.EdgeX]
 	// DevicesByServiceName returns devices associated with the specified device service.
 	// The result can be limited in a certain range by specifying the offset and limit parameters.
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	DevicesByServiceName(ctx context.Context, name string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX)
+	// IterDevicesByServiceName iterates over the devices of DevicesByServiceName, querying pageSize devices at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterDevicesByServiceName(ctx context.Context, name string, pageSize int) iter.Seq2[dtos.Device, errors.EdgeX]
 }
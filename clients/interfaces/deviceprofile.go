@@ -7,7 +7,9 @@ package interfaces
 
 import (
 	"context"
+	"iter"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
@@ -30,14 +32,29 @@ type DeviceProfileClient interface {
 	DeviceProfileByName(ctx context.Context, name string) (responses.DeviceProfileResponse, errors.EdgeX)
 	// AllDeviceProfiles queries all profiles
 	AllDeviceProfiles(ctx context.Context, labels []string, offset int, limit int) (responses.MultiDeviceProfilesResponse, errors.EdgeX)
+	// IterAllDeviceProfiles iterates over the device profiles of AllDeviceProfiles, querying pageSize device profiles at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterAllDeviceProfiles(ctx context.Context, labels []string, pageSize int) iter.Seq2[dtos.DeviceProfile, errors.EdgeX]
 	// AllDeviceProfileBasicInfos queries all profile basic infos
 	AllDeviceProfileBasicInfos(ctx context.Context, labels []string, offset int, limit int) (responses.MultiDeviceProfileBasicInfoResponse, errors.EdgeX)
+	// IterAllDeviceProfileBasicInfos iterates over the device profile basic infos of AllDeviceProfileBasicInfos, querying pageSize device profile basic infos at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterAllDeviceProfileBasicInfos(ctx context.Context, labels []string, pageSize int) iter.Seq2[dtos.DeviceProfileBasicInfo, errors.EdgeX]
 	// DeviceProfilesByModel queries profiles by model
 	DeviceProfilesByModel(ctx context.Context, model string, offset int, limit int) (responses.MultiDeviceProfilesResponse, errors.EdgeX)
+	// IterDeviceProfilesByModel iterates over the device profiles of DeviceProfilesByModel, querying pageSize device profiles at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterDeviceProfilesByModel(ctx context.Context, model string, pageSize int) iter.Seq2[dtos.DeviceProfile, errors.EdgeX]
 	// DeviceProfilesByManufacturer queries profiles by manufacturer
 	DeviceProfilesByManufacturer(ctx context.Context, manufacturer string, offset int, limit int) (responses.MultiDeviceProfilesResponse, errors.EdgeX)
+	// IterDeviceProfilesByManufacturer iterates over the device profiles of DeviceProfilesByManufacturer, querying pageSize device profiles at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterDeviceProfilesByManufacturer(ctx context.Context, manufacturer string, pageSize int) iter.Seq2[dtos.DeviceProfile, errors.EdgeX]
 	// DeviceProfilesByManufacturerAndModel queries profiles by manufacturer and model
 	DeviceProfilesByManufacturerAndModel(ctx context.Context, manufacturer string, model string, offset int, limit int) (responses.MultiDeviceProfilesResponse, errors.EdgeX)
+	// IterDeviceProfilesByManufacturerAndModel iterates over the device profiles of DeviceProfilesByManufacturerAndModel, querying pageSize device profiles at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterDeviceProfilesByManufacturerAndModel(ctx context.Context, manufacturer string, model string, pageSize int) iter.Seq2[dtos.DeviceProfile, errors.EdgeX]
 	// DeviceResourceByProfileNameAndResourceName queries the device resource by profileName and resourceName
 	DeviceResourceByProfileNameAndResourceName(ctx context.Context, profileName string, resourceName string) (responses.DeviceResourceResponse, errors.EdgeX)
 	// UpdateDeviceProfileBasicInfo updates existing profile's basic info
@@ -2,7 +2,9 @@ package interfaces
 
 import (
 	"context"
+	"iter"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
@@ -20,6 +22,9 @@ type DeviceServiceClient interface {
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	AllDeviceServices(ctx context.Context, labels []string, offset int, limit int) (responses.MultiDeviceServicesResponse, errors.EdgeX)
+	// IterAllDeviceServices iterates over the device services of AllDeviceServices, querying pageSize device services at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterAllDeviceServices(ctx context.Context, labels []string, pageSize int) iter.Seq2[dtos.DeviceService, errors.EdgeX]
 	// DeviceServiceByName returns a device service by name.
 	DeviceServiceByName(ctx context.Context, name string) (responses.DeviceServiceResponse, errors.EdgeX)
 	// DeleteByName deletes a device service by name.
@@ -7,7 +7,9 @@ package interfaces
 
 import (
 	"context"
+	"iter"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
@@ -23,6 +25,9 @@ type EventClient interface {
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	AllEvents(ctx context.Context, offset, limit int) (responses.MultiEventsResponse, errors.EdgeX)
+	// IterAllEvents iterates over the events of AllEvents, querying pageSize events at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterAllEvents(ctx context.Context, pageSize int) iter.Seq2[dtos.Event, errors.EdgeX]
 	// EventCount returns a count of all of events currently stored in the database.
 	EventCount(ctx context.Context) (common.CountResponse, errors.EdgeX)
 	// EventCountByDeviceName returns a count of all of events currently stored in the database, sourced from the specified device.
@@ -31,6 +36,9 @@ type EventClient interface {
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	EventsByDeviceName(ctx context.Context, name string, offset, limit int) (responses.MultiEventsResponse, errors.EdgeX)
+	// IterEventsByDeviceName iterates over the events of EventsByDeviceName, querying pageSize events at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterEventsByDeviceName(ctx context.Context, name string, pageSize int) iter.Seq2[dtos.Event, errors.EdgeX]
 	// DeleteByDeviceName deletes all events for the specified device.
 	DeleteByDeviceName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX)
 	// EventsByTimeRange returns events between a given start and end date/time. Events are sorted in descending order of created time.
@@ -38,6 +46,9 @@ type EventClient interface {
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	EventsByTimeRange(ctx context.Context, start, end int64, offset, limit int) (responses.MultiEventsResponse, errors.EdgeX)
+	// IterEventsByTimeRange iterates over the events of EventsByTimeRange, querying pageSize events at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterEventsByTimeRange(ctx context.Context, start int64, end int64, pageSize int) iter.Seq2[dtos.Event, errors.EdgeX]
 	// DeleteByAge deletes events that are older than the given age. Age is supposed in milliseconds from created timestamp.
 	DeleteByAge(ctx context.Context, age int) (common.BaseResponse, errors.EdgeX)
 	// DeleteById deletes an event by its id
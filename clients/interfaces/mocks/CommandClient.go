@@ -5,8 +5,12 @@ package mocks
 import (
 	context "context"
 
+	iter "iter"
+
 	common "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 
+	dtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	mock "github.com/stretchr/testify/mock"
@@ -166,6 +170,26 @@ type mockConstructorTestingTNewCommandClient interface {
 	Cleanup(func())
 }
 
+// IterAllDeviceCoreCommands provides a mock function with given fields: ctx, pageSize
+func (_m *CommandClient) IterAllDeviceCoreCommands(ctx context.Context, pageSize int) iter.Seq2[dtos.DeviceCoreCommand, errors.EdgeX] {
+	ret := _m.Called(ctx, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterAllDeviceCoreCommands")
+	}
+
+	var r0 iter.Seq2[dtos.DeviceCoreCommand, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, int) iter.Seq2[dtos.DeviceCoreCommand, errors.EdgeX]); ok {
+		r0 = rf(ctx, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.DeviceCoreCommand, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
 // NewCommandClient creates a new instance of CommandClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 func NewCommandClient(t mockConstructorTestingTNewCommandClient) *CommandClient {
 	mock := &CommandClient{}
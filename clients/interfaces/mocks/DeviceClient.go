@@ -5,8 +5,12 @@ package mocks
 import (
 	context "context"
 
+	iter "iter"
+
 	common "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 
+	dtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	mock "github.com/stretchr/testify/mock"
@@ -295,6 +299,86 @@ func (_m *DeviceClient) DevicesByServiceName(ctx context.Context, name string, o
 	return r0, r1
 }
 
+// IterAllDevices provides a mock function with given fields: ctx, labels, pageSize
+func (_m *DeviceClient) IterAllDevices(ctx context.Context, labels []string, pageSize int) iter.Seq2[dtos.Device, errors.EdgeX] {
+	ret := _m.Called(ctx, labels, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterAllDevices")
+	}
+
+	var r0 iter.Seq2[dtos.Device, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int) iter.Seq2[dtos.Device, errors.EdgeX]); ok {
+		r0 = rf(ctx, labels, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Device, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterAllDevicesWithChildren provides a mock function with given fields: ctx, parent, maxLevels, labels, pageSize
+func (_m *DeviceClient) IterAllDevicesWithChildren(ctx context.Context, parent string, maxLevels uint, labels []string, pageSize int) iter.Seq2[dtos.Device, errors.EdgeX] {
+	ret := _m.Called(ctx, parent, maxLevels, labels, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterAllDevicesWithChildren")
+	}
+
+	var r0 iter.Seq2[dtos.Device, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint, []string, int) iter.Seq2[dtos.Device, errors.EdgeX]); ok {
+		r0 = rf(ctx, parent, maxLevels, labels, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Device, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterDevicesByProfileName provides a mock function with given fields: ctx, name, pageSize
+func (_m *DeviceClient) IterDevicesByProfileName(ctx context.Context, name string, pageSize int) iter.Seq2[dtos.Device, errors.EdgeX] {
+	ret := _m.Called(ctx, name, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterDevicesByProfileName")
+	}
+
+	var r0 iter.Seq2[dtos.Device, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) iter.Seq2[dtos.Device, errors.EdgeX]); ok {
+		r0 = rf(ctx, name, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Device, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterDevicesByServiceName provides a mock function with given fields: ctx, name, pageSize
+func (_m *DeviceClient) IterDevicesByServiceName(ctx context.Context, name string, pageSize int) iter.Seq2[dtos.Device, errors.EdgeX] {
+	ret := _m.Called(ctx, name, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterDevicesByServiceName")
+	}
+
+	var r0 iter.Seq2[dtos.Device, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) iter.Seq2[dtos.Device, errors.EdgeX]); ok {
+		r0 = rf(ctx, name, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Device, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, reqs
 func (_m *DeviceClient) Update(ctx context.Context, reqs []requests.UpdateDeviceRequest) ([]common.BaseResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, reqs)
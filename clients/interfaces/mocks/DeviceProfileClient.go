@@ -5,8 +5,12 @@ package mocks
 import (
 	context "context"
 
+	iter "iter"
+
 	common "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 
+	dtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	mock "github.com/stretchr/testify/mock"
@@ -447,6 +451,106 @@ func (_m *DeviceProfileClient) DeviceResourceByProfileNameAndResourceName(ctx co
 	return r0, r1
 }
 
+// IterAllDeviceProfileBasicInfos provides a mock function with given fields: ctx, labels, pageSize
+func (_m *DeviceProfileClient) IterAllDeviceProfileBasicInfos(ctx context.Context, labels []string, pageSize int) iter.Seq2[dtos.DeviceProfileBasicInfo, errors.EdgeX] {
+	ret := _m.Called(ctx, labels, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterAllDeviceProfileBasicInfos")
+	}
+
+	var r0 iter.Seq2[dtos.DeviceProfileBasicInfo, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int) iter.Seq2[dtos.DeviceProfileBasicInfo, errors.EdgeX]); ok {
+		r0 = rf(ctx, labels, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.DeviceProfileBasicInfo, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterAllDeviceProfiles provides a mock function with given fields: ctx, labels, pageSize
+func (_m *DeviceProfileClient) IterAllDeviceProfiles(ctx context.Context, labels []string, pageSize int) iter.Seq2[dtos.DeviceProfile, errors.EdgeX] {
+	ret := _m.Called(ctx, labels, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterAllDeviceProfiles")
+	}
+
+	var r0 iter.Seq2[dtos.DeviceProfile, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int) iter.Seq2[dtos.DeviceProfile, errors.EdgeX]); ok {
+		r0 = rf(ctx, labels, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.DeviceProfile, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterDeviceProfilesByManufacturer provides a mock function with given fields: ctx, manufacturer, pageSize
+func (_m *DeviceProfileClient) IterDeviceProfilesByManufacturer(ctx context.Context, manufacturer string, pageSize int) iter.Seq2[dtos.DeviceProfile, errors.EdgeX] {
+	ret := _m.Called(ctx, manufacturer, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterDeviceProfilesByManufacturer")
+	}
+
+	var r0 iter.Seq2[dtos.DeviceProfile, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) iter.Seq2[dtos.DeviceProfile, errors.EdgeX]); ok {
+		r0 = rf(ctx, manufacturer, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.DeviceProfile, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterDeviceProfilesByManufacturerAndModel provides a mock function with given fields: ctx, manufacturer, model, pageSize
+func (_m *DeviceProfileClient) IterDeviceProfilesByManufacturerAndModel(ctx context.Context, manufacturer string, model string, pageSize int) iter.Seq2[dtos.DeviceProfile, errors.EdgeX] {
+	ret := _m.Called(ctx, manufacturer, model, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterDeviceProfilesByManufacturerAndModel")
+	}
+
+	var r0 iter.Seq2[dtos.DeviceProfile, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) iter.Seq2[dtos.DeviceProfile, errors.EdgeX]); ok {
+		r0 = rf(ctx, manufacturer, model, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.DeviceProfile, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterDeviceProfilesByModel provides a mock function with given fields: ctx, model, pageSize
+func (_m *DeviceProfileClient) IterDeviceProfilesByModel(ctx context.Context, model string, pageSize int) iter.Seq2[dtos.DeviceProfile, errors.EdgeX] {
+	ret := _m.Called(ctx, model, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterDeviceProfilesByModel")
+	}
+
+	var r0 iter.Seq2[dtos.DeviceProfile, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) iter.Seq2[dtos.DeviceProfile, errors.EdgeX]); ok {
+		r0 = rf(ctx, model, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.DeviceProfile, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, reqs
 func (_m *DeviceProfileClient) Update(ctx context.Context, reqs []requests.DeviceProfileRequest) ([]common.BaseResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, reqs)
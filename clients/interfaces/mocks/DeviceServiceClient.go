@@ -5,8 +5,12 @@ package mocks
 import (
 	context "context"
 
+	iter "iter"
+
 	common "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 
+	dtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	mock "github.com/stretchr/testify/mock"
@@ -115,6 +119,26 @@ func (_m *DeviceServiceClient) DeviceServiceByName(ctx context.Context, name str
 	return r0, r1
 }
 
+// IterAllDeviceServices provides a mock function with given fields: ctx, labels, pageSize
+func (_m *DeviceServiceClient) IterAllDeviceServices(ctx context.Context, labels []string, pageSize int) iter.Seq2[dtos.DeviceService, errors.EdgeX] {
+	ret := _m.Called(ctx, labels, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterAllDeviceServices")
+	}
+
+	var r0 iter.Seq2[dtos.DeviceService, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int) iter.Seq2[dtos.DeviceService, errors.EdgeX]); ok {
+		r0 = rf(ctx, labels, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.DeviceService, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, reqs
 func (_m *DeviceServiceClient) Update(ctx context.Context, reqs []requests.UpdateDeviceServiceRequest) ([]common.BaseResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, reqs)
@@ -5,8 +5,12 @@ package mocks
 import (
 	context "context"
 
+	iter "iter"
+
 	common "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 
+	dtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	mock "github.com/stretchr/testify/mock"
@@ -257,6 +261,66 @@ type mockConstructorTestingTNewEventClient interface {
 	Cleanup(func())
 }
 
+// IterAllEvents provides a mock function with given fields: ctx, pageSize
+func (_m *EventClient) IterAllEvents(ctx context.Context, pageSize int) iter.Seq2[dtos.Event, errors.EdgeX] {
+	ret := _m.Called(ctx, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterAllEvents")
+	}
+
+	var r0 iter.Seq2[dtos.Event, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, int) iter.Seq2[dtos.Event, errors.EdgeX]); ok {
+		r0 = rf(ctx, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Event, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterEventsByDeviceName provides a mock function with given fields: ctx, name, pageSize
+func (_m *EventClient) IterEventsByDeviceName(ctx context.Context, name string, pageSize int) iter.Seq2[dtos.Event, errors.EdgeX] {
+	ret := _m.Called(ctx, name, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterEventsByDeviceName")
+	}
+
+	var r0 iter.Seq2[dtos.Event, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) iter.Seq2[dtos.Event, errors.EdgeX]); ok {
+		r0 = rf(ctx, name, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Event, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterEventsByTimeRange provides a mock function with given fields: ctx, start, end, pageSize
+func (_m *EventClient) IterEventsByTimeRange(ctx context.Context, start int64, end int64, pageSize int) iter.Seq2[dtos.Event, errors.EdgeX] {
+	ret := _m.Called(ctx, start, end, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterEventsByTimeRange")
+	}
+
+	var r0 iter.Seq2[dtos.Event, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int) iter.Seq2[dtos.Event, errors.EdgeX]); ok {
+		r0 = rf(ctx, start, end, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Event, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
 // NewEventClient creates a new instance of EventClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 func NewEventClient(t mockConstructorTestingTNewEventClient) *EventClient {
 	mock := &EventClient{}
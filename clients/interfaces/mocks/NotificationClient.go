@@ -5,8 +5,12 @@ package mocks
 import (
 	context "context"
 
+	iter "iter"
+
 	common "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 
+	dtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	mock "github.com/stretchr/testify/mock"
@@ -136,6 +140,126 @@ func (_m *NotificationClient) DeleteProcessedNotificationsByAge(ctx context.Cont
 	return r0, r1
 }
 
+// IterNotificationsByCategory provides a mock function with given fields: ctx, category, pageSize, ack
+func (_m *NotificationClient) IterNotificationsByCategory(ctx context.Context, category string, pageSize int, ack string) iter.Seq2[dtos.Notification, errors.EdgeX] {
+	ret := _m.Called(ctx, category, pageSize, ack)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterNotificationsByCategory")
+	}
+
+	var r0 iter.Seq2[dtos.Notification, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) iter.Seq2[dtos.Notification, errors.EdgeX]); ok {
+		r0 = rf(ctx, category, pageSize, ack)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Notification, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterNotificationsByLabel provides a mock function with given fields: ctx, label, pageSize, ack
+func (_m *NotificationClient) IterNotificationsByLabel(ctx context.Context, label string, pageSize int, ack string) iter.Seq2[dtos.Notification, errors.EdgeX] {
+	ret := _m.Called(ctx, label, pageSize, ack)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterNotificationsByLabel")
+	}
+
+	var r0 iter.Seq2[dtos.Notification, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) iter.Seq2[dtos.Notification, errors.EdgeX]); ok {
+		r0 = rf(ctx, label, pageSize, ack)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Notification, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterNotificationsByQueryConditions provides a mock function with given fields: ctx, pageSize, ack, conditionReq
+func (_m *NotificationClient) IterNotificationsByQueryConditions(ctx context.Context, pageSize int, ack string, conditionReq requests.GetNotificationRequest) iter.Seq2[dtos.Notification, errors.EdgeX] {
+	ret := _m.Called(ctx, pageSize, ack, conditionReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterNotificationsByQueryConditions")
+	}
+
+	var r0 iter.Seq2[dtos.Notification, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, requests.GetNotificationRequest) iter.Seq2[dtos.Notification, errors.EdgeX]); ok {
+		r0 = rf(ctx, pageSize, ack, conditionReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Notification, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterNotificationsByStatus provides a mock function with given fields: ctx, status, pageSize, ack
+func (_m *NotificationClient) IterNotificationsByStatus(ctx context.Context, status string, pageSize int, ack string) iter.Seq2[dtos.Notification, errors.EdgeX] {
+	ret := _m.Called(ctx, status, pageSize, ack)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterNotificationsByStatus")
+	}
+
+	var r0 iter.Seq2[dtos.Notification, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) iter.Seq2[dtos.Notification, errors.EdgeX]); ok {
+		r0 = rf(ctx, status, pageSize, ack)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Notification, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterNotificationsBySubscriptionName provides a mock function with given fields: ctx, subscriptionName, pageSize, ack
+func (_m *NotificationClient) IterNotificationsBySubscriptionName(ctx context.Context, subscriptionName string, pageSize int, ack string) iter.Seq2[dtos.Notification, errors.EdgeX] {
+	ret := _m.Called(ctx, subscriptionName, pageSize, ack)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterNotificationsBySubscriptionName")
+	}
+
+	var r0 iter.Seq2[dtos.Notification, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) iter.Seq2[dtos.Notification, errors.EdgeX]); ok {
+		r0 = rf(ctx, subscriptionName, pageSize, ack)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Notification, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterNotificationsByTimeRange provides a mock function with given fields: ctx, start, end, pageSize, ack
+func (_m *NotificationClient) IterNotificationsByTimeRange(ctx context.Context, start int64, end int64, pageSize int, ack string) iter.Seq2[dtos.Notification, errors.EdgeX] {
+	ret := _m.Called(ctx, start, end, pageSize, ack)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterNotificationsByTimeRange")
+	}
+
+	var r0 iter.Seq2[dtos.Notification, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int, string) iter.Seq2[dtos.Notification, errors.EdgeX]); ok {
+		r0 = rf(ctx, start, end, pageSize, ack)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Notification, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
 // NotificationById provides a mock function with given fields: ctx, id
 func (_m *NotificationClient) NotificationById(ctx context.Context, id string) (responses.NotificationResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, id)
@@ -5,8 +5,12 @@ package mocks
 import (
 	context "context"
 
+	iter "iter"
+
 	common "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 
+	dtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	mock "github.com/stretchr/testify/mock"
@@ -92,6 +96,66 @@ func (_m *ProvisionWatcherClient) DeleteProvisionWatcherByName(ctx context.Conte
 	return r0, r1
 }
 
+// IterAllProvisionWatchers provides a mock function with given fields: ctx, labels, pageSize
+func (_m *ProvisionWatcherClient) IterAllProvisionWatchers(ctx context.Context, labels []string, pageSize int) iter.Seq2[dtos.ProvisionWatcher, errors.EdgeX] {
+	ret := _m.Called(ctx, labels, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterAllProvisionWatchers")
+	}
+
+	var r0 iter.Seq2[dtos.ProvisionWatcher, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int) iter.Seq2[dtos.ProvisionWatcher, errors.EdgeX]); ok {
+		r0 = rf(ctx, labels, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.ProvisionWatcher, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterProvisionWatchersByProfileName provides a mock function with given fields: ctx, name, pageSize
+func (_m *ProvisionWatcherClient) IterProvisionWatchersByProfileName(ctx context.Context, name string, pageSize int) iter.Seq2[dtos.ProvisionWatcher, errors.EdgeX] {
+	ret := _m.Called(ctx, name, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterProvisionWatchersByProfileName")
+	}
+
+	var r0 iter.Seq2[dtos.ProvisionWatcher, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) iter.Seq2[dtos.ProvisionWatcher, errors.EdgeX]); ok {
+		r0 = rf(ctx, name, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.ProvisionWatcher, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterProvisionWatchersByServiceName provides a mock function with given fields: ctx, name, pageSize
+func (_m *ProvisionWatcherClient) IterProvisionWatchersByServiceName(ctx context.Context, name string, pageSize int) iter.Seq2[dtos.ProvisionWatcher, errors.EdgeX] {
+	ret := _m.Called(ctx, name, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterProvisionWatchersByServiceName")
+	}
+
+	var r0 iter.Seq2[dtos.ProvisionWatcher, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) iter.Seq2[dtos.ProvisionWatcher, errors.EdgeX]); ok {
+		r0 = rf(ctx, name, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.ProvisionWatcher, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
 // ProvisionWatcherByName provides a mock function with given fields: ctx, name
 func (_m *ProvisionWatcherClient) ProvisionWatcherByName(ctx context.Context, name string) (responses.ProvisionWatcherResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, name)
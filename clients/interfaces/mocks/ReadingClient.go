@@ -5,8 +5,12 @@ package mocks
 import (
 	context "context"
 
+	iter "iter"
+
 	common "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 
+	dtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	mock "github.com/stretchr/testify/mock"
@@ -42,6 +46,166 @@ func (_m *ReadingClient) AllReadings(ctx context.Context, offset int, limit int)
 	return r0, r1
 }
 
+// IterAllReadings provides a mock function with given fields: ctx, pageSize
+func (_m *ReadingClient) IterAllReadings(ctx context.Context, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX] {
+	ret := _m.Called(ctx, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterAllReadings")
+	}
+
+	var r0 iter.Seq2[dtos.BaseReading, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, int) iter.Seq2[dtos.BaseReading, errors.EdgeX]); ok {
+		r0 = rf(ctx, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.BaseReading, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterReadingsByDeviceName provides a mock function with given fields: ctx, name, pageSize
+func (_m *ReadingClient) IterReadingsByDeviceName(ctx context.Context, name string, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX] {
+	ret := _m.Called(ctx, name, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterReadingsByDeviceName")
+	}
+
+	var r0 iter.Seq2[dtos.BaseReading, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) iter.Seq2[dtos.BaseReading, errors.EdgeX]); ok {
+		r0 = rf(ctx, name, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.BaseReading, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterReadingsByDeviceNameAndResourceName provides a mock function with given fields: ctx, deviceName, resourceName, pageSize
+func (_m *ReadingClient) IterReadingsByDeviceNameAndResourceName(ctx context.Context, deviceName string, resourceName string, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX] {
+	ret := _m.Called(ctx, deviceName, resourceName, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterReadingsByDeviceNameAndResourceName")
+	}
+
+	var r0 iter.Seq2[dtos.BaseReading, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) iter.Seq2[dtos.BaseReading, errors.EdgeX]); ok {
+		r0 = rf(ctx, deviceName, resourceName, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.BaseReading, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterReadingsByDeviceNameAndResourceNameAndTimeRange provides a mock function with given fields: ctx, deviceName, resourceName, start, end, pageSize
+func (_m *ReadingClient) IterReadingsByDeviceNameAndResourceNameAndTimeRange(ctx context.Context, deviceName string, resourceName string, start int64, end int64, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX] {
+	ret := _m.Called(ctx, deviceName, resourceName, start, end, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterReadingsByDeviceNameAndResourceNameAndTimeRange")
+	}
+
+	var r0 iter.Seq2[dtos.BaseReading, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, int64, int) iter.Seq2[dtos.BaseReading, errors.EdgeX]); ok {
+		r0 = rf(ctx, deviceName, resourceName, start, end, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.BaseReading, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterReadingsByDeviceNameAndResourceNamesAndTimeRange provides a mock function with given fields: ctx, deviceName, resourceNames, start, end, pageSize
+func (_m *ReadingClient) IterReadingsByDeviceNameAndResourceNamesAndTimeRange(ctx context.Context, deviceName string, resourceNames []string, start int64, end int64, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX] {
+	ret := _m.Called(ctx, deviceName, resourceNames, start, end, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterReadingsByDeviceNameAndResourceNamesAndTimeRange")
+	}
+
+	var r0 iter.Seq2[dtos.BaseReading, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, int64, int64, int) iter.Seq2[dtos.BaseReading, errors.EdgeX]); ok {
+		r0 = rf(ctx, deviceName, resourceNames, start, end, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.BaseReading, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterReadingsByResourceName provides a mock function with given fields: ctx, name, pageSize
+func (_m *ReadingClient) IterReadingsByResourceName(ctx context.Context, name string, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX] {
+	ret := _m.Called(ctx, name, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterReadingsByResourceName")
+	}
+
+	var r0 iter.Seq2[dtos.BaseReading, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) iter.Seq2[dtos.BaseReading, errors.EdgeX]); ok {
+		r0 = rf(ctx, name, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.BaseReading, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterReadingsByResourceNameAndTimeRange provides a mock function with given fields: ctx, name, start, end, pageSize
+func (_m *ReadingClient) IterReadingsByResourceNameAndTimeRange(ctx context.Context, name string, start int64, end int64, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX] {
+	ret := _m.Called(ctx, name, start, end, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterReadingsByResourceNameAndTimeRange")
+	}
+
+	var r0 iter.Seq2[dtos.BaseReading, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, int) iter.Seq2[dtos.BaseReading, errors.EdgeX]); ok {
+		r0 = rf(ctx, name, start, end, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.BaseReading, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterReadingsByTimeRange provides a mock function with given fields: ctx, start, end, pageSize
+func (_m *ReadingClient) IterReadingsByTimeRange(ctx context.Context, start int64, end int64, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX] {
+	ret := _m.Called(ctx, start, end, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterReadingsByTimeRange")
+	}
+
+	var r0 iter.Seq2[dtos.BaseReading, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int) iter.Seq2[dtos.BaseReading, errors.EdgeX]); ok {
+		r0 = rf(ctx, start, end, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.BaseReading, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
 // ReadingCount provides a mock function with given fields: ctx
 func (_m *ReadingClient) ReadingCount(ctx context.Context) (common.CountResponse, errors.EdgeX) {
 	ret := _m.Called(ctx)
@@ -5,6 +5,10 @@ package mocks
 import (
 	context "context"
 
+	iter "iter"
+
+	dtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	mock "github.com/stretchr/testify/mock"
@@ -47,6 +51,86 @@ func (_m *ScheduleActionRecordClient) AllScheduleActionRecords(ctx context.Conte
 	return r0, r1
 }
 
+// IterAllScheduleActionRecords provides a mock function with given fields: ctx, start, end, pageSize
+func (_m *ScheduleActionRecordClient) IterAllScheduleActionRecords(ctx context.Context, start int64, end int64, pageSize int) iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX] {
+	ret := _m.Called(ctx, start, end, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterAllScheduleActionRecords")
+	}
+
+	var r0 iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int) iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX]); ok {
+		r0 = rf(ctx, start, end, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterScheduleActionRecordsByJobName provides a mock function with given fields: ctx, jobName, start, end, pageSize
+func (_m *ScheduleActionRecordClient) IterScheduleActionRecordsByJobName(ctx context.Context, jobName string, start int64, end int64, pageSize int) iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX] {
+	ret := _m.Called(ctx, jobName, start, end, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterScheduleActionRecordsByJobName")
+	}
+
+	var r0 iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, int) iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX]); ok {
+		r0 = rf(ctx, jobName, start, end, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterScheduleActionRecordsByJobNameAndStatus provides a mock function with given fields: ctx, jobName, status, start, end, pageSize
+func (_m *ScheduleActionRecordClient) IterScheduleActionRecordsByJobNameAndStatus(ctx context.Context, jobName string, status string, start int64, end int64, pageSize int) iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX] {
+	ret := _m.Called(ctx, jobName, status, start, end, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterScheduleActionRecordsByJobNameAndStatus")
+	}
+
+	var r0 iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, int64, int) iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX]); ok {
+		r0 = rf(ctx, jobName, status, start, end, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterScheduleActionRecordsByStatus provides a mock function with given fields: ctx, status, start, end, pageSize
+func (_m *ScheduleActionRecordClient) IterScheduleActionRecordsByStatus(ctx context.Context, status string, start int64, end int64, pageSize int) iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX] {
+	ret := _m.Called(ctx, status, start, end, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterScheduleActionRecordsByStatus")
+	}
+
+	var r0 iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, int) iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX]); ok {
+		r0 = rf(ctx, status, start, end, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
 // LatestScheduleActionRecordsByJobName provides a mock function with given fields: ctx, jobName
 func (_m *ScheduleActionRecordClient) LatestScheduleActionRecordsByJobName(ctx context.Context, jobName string) (responses.MultiScheduleActionRecordsResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, jobName)
@@ -5,8 +5,12 @@ package mocks
 import (
 	context "context"
 
+	iter "iter"
+
 	common "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 
+	dtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	mock "github.com/stretchr/testify/mock"
@@ -113,6 +117,26 @@ func (_m *ScheduleJobClient) DeleteScheduleJobByName(ctx context.Context, name s
 	return r0, r1
 }
 
+// IterAllScheduleJobs provides a mock function with given fields: ctx, labels, pageSize
+func (_m *ScheduleJobClient) IterAllScheduleJobs(ctx context.Context, labels []string, pageSize int) iter.Seq2[dtos.ScheduleJob, errors.EdgeX] {
+	ret := _m.Called(ctx, labels, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterAllScheduleJobs")
+	}
+
+	var r0 iter.Seq2[dtos.ScheduleJob, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int) iter.Seq2[dtos.ScheduleJob, errors.EdgeX]); ok {
+		r0 = rf(ctx, labels, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.ScheduleJob, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
 // ScheduleJobByName provides a mock function with given fields: ctx, name
 func (_m *ScheduleJobClient) ScheduleJobByName(ctx context.Context, name string) (responses.ScheduleJobResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, name)
@@ -5,8 +5,12 @@ package mocks
 import (
 	context "context"
 
+	iter "iter"
+
 	common "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 
+	dtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	mock "github.com/stretchr/testify/mock"
@@ -92,6 +96,86 @@ func (_m *SubscriptionClient) DeleteSubscriptionByName(ctx context.Context, name
 	return r0, r1
 }
 
+// IterAllSubscriptions provides a mock function with given fields: ctx, pageSize
+func (_m *SubscriptionClient) IterAllSubscriptions(ctx context.Context, pageSize int) iter.Seq2[dtos.Subscription, errors.EdgeX] {
+	ret := _m.Called(ctx, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterAllSubscriptions")
+	}
+
+	var r0 iter.Seq2[dtos.Subscription, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, int) iter.Seq2[dtos.Subscription, errors.EdgeX]); ok {
+		r0 = rf(ctx, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Subscription, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterSubscriptionsByCategory provides a mock function with given fields: ctx, category, pageSize
+func (_m *SubscriptionClient) IterSubscriptionsByCategory(ctx context.Context, category string, pageSize int) iter.Seq2[dtos.Subscription, errors.EdgeX] {
+	ret := _m.Called(ctx, category, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterSubscriptionsByCategory")
+	}
+
+	var r0 iter.Seq2[dtos.Subscription, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) iter.Seq2[dtos.Subscription, errors.EdgeX]); ok {
+		r0 = rf(ctx, category, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Subscription, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterSubscriptionsByLabel provides a mock function with given fields: ctx, label, pageSize
+func (_m *SubscriptionClient) IterSubscriptionsByLabel(ctx context.Context, label string, pageSize int) iter.Seq2[dtos.Subscription, errors.EdgeX] {
+	ret := _m.Called(ctx, label, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterSubscriptionsByLabel")
+	}
+
+	var r0 iter.Seq2[dtos.Subscription, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) iter.Seq2[dtos.Subscription, errors.EdgeX]); ok {
+		r0 = rf(ctx, label, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Subscription, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterSubscriptionsByReceiver provides a mock function with given fields: ctx, receiver, pageSize
+func (_m *SubscriptionClient) IterSubscriptionsByReceiver(ctx context.Context, receiver string, pageSize int) iter.Seq2[dtos.Subscription, errors.EdgeX] {
+	ret := _m.Called(ctx, receiver, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterSubscriptionsByReceiver")
+	}
+
+	var r0 iter.Seq2[dtos.Subscription, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) iter.Seq2[dtos.Subscription, errors.EdgeX]); ok {
+		r0 = rf(ctx, receiver, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Subscription, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
 // SubscriptionByName provides a mock function with given fields: ctx, name
 func (_m *SubscriptionClient) SubscriptionByName(ctx context.Context, name string) (responses.SubscriptionResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, name)
@@ -5,8 +5,12 @@ package mocks
 import (
 	context "context"
 
+	iter "iter"
+
 	common "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 
+	dtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	mock "github.com/stretchr/testify/mock"
@@ -65,6 +69,106 @@ func (_m *TransmissionClient) DeleteProcessedTransmissionsByAge(ctx context.Cont
 	return r0, r1
 }
 
+// IterAllTransmissions provides a mock function with given fields: ctx, pageSize
+func (_m *TransmissionClient) IterAllTransmissions(ctx context.Context, pageSize int) iter.Seq2[dtos.Transmission, errors.EdgeX] {
+	ret := _m.Called(ctx, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterAllTransmissions")
+	}
+
+	var r0 iter.Seq2[dtos.Transmission, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, int) iter.Seq2[dtos.Transmission, errors.EdgeX]); ok {
+		r0 = rf(ctx, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Transmission, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterTransmissionsByNotificationId provides a mock function with given fields: ctx, id, pageSize
+func (_m *TransmissionClient) IterTransmissionsByNotificationId(ctx context.Context, id string, pageSize int) iter.Seq2[dtos.Transmission, errors.EdgeX] {
+	ret := _m.Called(ctx, id, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterTransmissionsByNotificationId")
+	}
+
+	var r0 iter.Seq2[dtos.Transmission, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) iter.Seq2[dtos.Transmission, errors.EdgeX]); ok {
+		r0 = rf(ctx, id, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Transmission, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterTransmissionsByStatus provides a mock function with given fields: ctx, status, pageSize
+func (_m *TransmissionClient) IterTransmissionsByStatus(ctx context.Context, status string, pageSize int) iter.Seq2[dtos.Transmission, errors.EdgeX] {
+	ret := _m.Called(ctx, status, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterTransmissionsByStatus")
+	}
+
+	var r0 iter.Seq2[dtos.Transmission, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) iter.Seq2[dtos.Transmission, errors.EdgeX]); ok {
+		r0 = rf(ctx, status, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Transmission, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterTransmissionsBySubscriptionName provides a mock function with given fields: ctx, subscriptionName, pageSize
+func (_m *TransmissionClient) IterTransmissionsBySubscriptionName(ctx context.Context, subscriptionName string, pageSize int) iter.Seq2[dtos.Transmission, errors.EdgeX] {
+	ret := _m.Called(ctx, subscriptionName, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterTransmissionsBySubscriptionName")
+	}
+
+	var r0 iter.Seq2[dtos.Transmission, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) iter.Seq2[dtos.Transmission, errors.EdgeX]); ok {
+		r0 = rf(ctx, subscriptionName, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Transmission, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
+// IterTransmissionsByTimeRange provides a mock function with given fields: ctx, start, end, pageSize
+func (_m *TransmissionClient) IterTransmissionsByTimeRange(ctx context.Context, start int64, end int64, pageSize int) iter.Seq2[dtos.Transmission, errors.EdgeX] {
+	ret := _m.Called(ctx, start, end, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterTransmissionsByTimeRange")
+	}
+
+	var r0 iter.Seq2[dtos.Transmission, errors.EdgeX]
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int) iter.Seq2[dtos.Transmission, errors.EdgeX]); ok {
+		r0 = rf(ctx, start, end, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[dtos.Transmission, errors.EdgeX])
+		}
+	}
+
+	return r0
+}
+
 // TransmissionById provides a mock function with given fields: ctx, id
 func (_m *TransmissionClient) TransmissionById(ctx context.Context, id string) (responses.TransmissionResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, id)
@@ -7,7 +7,9 @@ package interfaces
 
 import (
 	"context"
+	"iter"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
@@ -24,14 +26,29 @@ type NotificationClient interface {
 	DeleteNotificationById(ctx context.Context, id string) (common.BaseResponse, errors.EdgeX)
 	// NotificationsByCategory queries notifications with category, offset, ack and limit
 	NotificationsByCategory(ctx context.Context, category string, offset int, limit int, ack string) (responses.MultiNotificationsResponse, errors.EdgeX)
+	// IterNotificationsByCategory iterates over the notifications of NotificationsByCategory, querying pageSize notifications at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterNotificationsByCategory(ctx context.Context, category string, pageSize int, ack string) iter.Seq2[dtos.Notification, errors.EdgeX]
 	// NotificationsByLabel queries notifications with label, offset, ack and limit
 	NotificationsByLabel(ctx context.Context, label string, offset int, limit int, ack string) (responses.MultiNotificationsResponse, errors.EdgeX)
+	// IterNotificationsByLabel iterates over the notifications of NotificationsByLabel, querying pageSize notifications at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterNotificationsByLabel(ctx context.Context, label string, pageSize int, ack string) iter.Seq2[dtos.Notification, errors.EdgeX]
 	// NotificationsByStatus queries notifications with status, offset, ack and limit
 	NotificationsByStatus(ctx context.Context, status string, offset int, limit int, ack string) (responses.MultiNotificationsResponse, errors.EdgeX)
+	// IterNotificationsByStatus iterates over the notifications of NotificationsByStatus, querying pageSize notifications at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterNotificationsByStatus(ctx context.Context, status string, pageSize int, ack string) iter.Seq2[dtos.Notification, errors.EdgeX]
 	// NotificationsByTimeRange query notifications with time range, offset, ack and limit
 	NotificationsByTimeRange(ctx context.Context, start, end int64, offset int, limit int, ack string) (responses.MultiNotificationsResponse, errors.EdgeX)
+	// IterNotificationsByTimeRange iterates over the notifications of NotificationsByTimeRange, querying pageSize notifications at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterNotificationsByTimeRange(ctx context.Context, start int64, end int64, pageSize int, ack string) iter.Seq2[dtos.Notification, errors.EdgeX]
 	// NotificationsBySubscriptionName query notifications with subscriptionName, offset, ack and limit
 	NotificationsBySubscriptionName(ctx context.Context, subscriptionName string, offset int, limit int, ack string) (responses.MultiNotificationsResponse, errors.EdgeX)
+	// IterNotificationsBySubscriptionName iterates over the notifications of NotificationsBySubscriptionName, querying pageSize notifications at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterNotificationsBySubscriptionName(ctx context.Context, subscriptionName string, pageSize int, ack string) iter.Seq2[dtos.Notification, errors.EdgeX]
 	// CleanupNotificationsByAge removes notifications that are older than age. And the corresponding transmissions will also be deleted.
 	// Age is supposed in milliseconds since modified timestamp
 	CleanupNotificationsByAge(ctx context.Context, age int) (common.BaseResponse, errors.EdgeX)
@@ -43,6 +60,9 @@ type NotificationClient interface {
 	DeleteProcessedNotificationsByAge(ctx context.Context, age int) (common.BaseResponse, errors.EdgeX)
 	// NotificationsByQueryConditions queries notifications with offset, limit, acknowledgement status, category and time range
 	NotificationsByQueryConditions(ctx context.Context, offset, limit int, ack string, conditionReq requests.GetNotificationRequest) (responses.MultiNotificationsResponse, errors.EdgeX)
+	// IterNotificationsByQueryConditions iterates over the notifications of NotificationsByQueryConditions, querying pageSize notifications at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterNotificationsByQueryConditions(ctx context.Context, pageSize int, ack string, conditionReq requests.GetNotificationRequest) iter.Seq2[dtos.Notification, errors.EdgeX]
 	// DeleteNotificationByIds deletes notifications by ids
 	DeleteNotificationByIds(ctx context.Context, ids []string) (common.BaseResponse, errors.EdgeX)
 	// UpdateNotificationAckStatusByIds updates existing notification's acknowledgement status
@@ -7,7 +7,9 @@ package interfaces
 
 import (
 	"context"
+	"iter"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
@@ -25,6 +27,9 @@ type ProvisionWatcherClient interface {
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	AllProvisionWatchers(ctx context.Context, labels []string, offset int, limit int) (responses.MultiProvisionWatchersResponse, errors.EdgeX)
+	// IterAllProvisionWatchers iterates over the provision watchers of AllProvisionWatchers, querying pageSize provision watchers at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterAllProvisionWatchers(ctx context.Context, labels []string, pageSize int) iter.Seq2[dtos.ProvisionWatcher, errors.EdgeX]
 	// ProvisionWatcherByName returns a provision watcher by name.
 	ProvisionWatcherByName(ctx context.Context, name string) (responses.ProvisionWatcherResponse, errors.EdgeX)
 	// DeleteProvisionWatcherByName deletes a provision watcher by name.
@@ -34,9 +39,15 @@ type ProvisionWatcherClient interface {
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	ProvisionWatchersByProfileName(ctx context.Context, name string, offset int, limit int) (responses.MultiProvisionWatchersResponse, errors.EdgeX)
+	// IterProvisionWatchersByProfileName iterates over the provision watchers of ProvisionWatchersByProfileName, querying pageSize provision watchers at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterProvisionWatchersByProfileName(ctx context.Context, name string, pageSize int) iter.Seq2[dtos.ProvisionWatcher, errors.EdgeX]
 	// ProvisionWatchersByServiceName returns provision watchers associated with the specified device service name.
 	// The result can be limited in a certain range by specifying the offset and limit parameters.
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	ProvisionWatchersByServiceName(ctx context.Context, name string, offset int, limit int) (responses.MultiProvisionWatchersResponse, errors.EdgeX)
+	// IterProvisionWatchersByServiceName iterates over the provision watchers of ProvisionWatchersByServiceName, querying pageSize provision watchers at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterProvisionWatchersByServiceName(ctx context.Context, name string, pageSize int) iter.Seq2[dtos.ProvisionWatcher, errors.EdgeX]
 }
@@ -7,7 +7,9 @@ package interfaces
 
 import (
 	"context"
+	"iter"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -20,6 +22,9 @@ type ReadingClient interface {
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	AllReadings(ctx context.Context, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX)
+	// IterAllReadings iterates over the readings of AllReadings, querying pageSize readings at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterAllReadings(ctx context.Context, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX]
 	// ReadingCount returns a count of all readings currently stored in the database.
 	ReadingCount(ctx context.Context) (common.CountResponse, errors.EdgeX)
 	// ReadingCountByDeviceName returns a count of all readings currently stored in the database, sourced from the specified device.
@@ -28,33 +33,54 @@ type ReadingClient interface {
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	ReadingsByDeviceName(ctx context.Context, name string, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX)
+	// IterReadingsByDeviceName iterates over the readings of ReadingsByDeviceName, querying pageSize readings at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterReadingsByDeviceName(ctx context.Context, name string, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX]
 	// ReadingsByResourceName returns a portion of the entire readings according to the device resource name, offset and limit parameters. Readings are sorted in descending order of created time.
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	ReadingsByResourceName(ctx context.Context, name string, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX)
+	// IterReadingsByResourceName iterates over the readings of ReadingsByResourceName, querying pageSize readings at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterReadingsByResourceName(ctx context.Context, name string, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX]
 	// ReadingsByTimeRange returns readings between a given start and end date/time. Readings are sorted in descending order of created time.
 	// start, end: Unix timestamp, indicating the date/time range.
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	ReadingsByTimeRange(ctx context.Context, start, end int64, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX)
+	// IterReadingsByTimeRange iterates over the readings of ReadingsByTimeRange, querying pageSize readings at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterReadingsByTimeRange(ctx context.Context, start int64, end int64, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX]
 	// ReadingsByResourceNameAndTimeRange returns readings by resource name and specified time range. Readings are sorted in descending order of origin time.
 	// start, end: Unix timestamp, indicating the date/time range
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	ReadingsByResourceNameAndTimeRange(ctx context.Context, name string, start, end int64, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX)
+	// IterReadingsByResourceNameAndTimeRange iterates over the readings of ReadingsByResourceNameAndTimeRange, querying pageSize readings at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterReadingsByResourceNameAndTimeRange(ctx context.Context, name string, start int64, end int64, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX]
 	// ReadingsByDeviceNameAndResourceName returns readings by device name and resource name. Readings are sorted in descending order of origin time.
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	ReadingsByDeviceNameAndResourceName(ctx context.Context, deviceName, resourceName string, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX)
+	// IterReadingsByDeviceNameAndResourceName iterates over the readings of ReadingsByDeviceNameAndResourceName, querying pageSize readings at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterReadingsByDeviceNameAndResourceName(ctx context.Context, deviceName string, resourceName string, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX]
 	// ReadingsByDeviceNameAndResourceNameAndTimeRange returns readings by device name, resource name and specified time range. Readings are sorted in descending order of origin time.
 	// start, end: Unix timestamp, indicating the date/time range
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	ReadingsByDeviceNameAndResourceNameAndTimeRange(ctx context.Context, deviceName, resourceName string, start, end int64, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX)
+	// IterReadingsByDeviceNameAndResourceNameAndTimeRange iterates over the readings of ReadingsByDeviceNameAndResourceNameAndTimeRange, querying pageSize readings at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterReadingsByDeviceNameAndResourceNameAndTimeRange(ctx context.Context, deviceName string, resourceName string, start int64, end int64, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX]
 	// ReadingsByDeviceNameAndResourceNamesAndTimeRange returns readings by device name, multiple resource names and specified time range. Readings are sorted in descending order of origin time.
 	// If none of resourceNames is specified, return all Readings under specified deviceName and within specified time range
 	// start, end: Unix timestamp, indicating the date/time range
 	// offset: The number of items to skip before starting to collect the result set. Default is 0.
 	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
 	ReadingsByDeviceNameAndResourceNamesAndTimeRange(ctx context.Context, deviceName string, resourceNames []string, start, end int64, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX)
+	// IterReadingsByDeviceNameAndResourceNamesAndTimeRange iterates over the readings of ReadingsByDeviceNameAndResourceNamesAndTimeRange, querying pageSize readings at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterReadingsByDeviceNameAndResourceNamesAndTimeRange(ctx context.Context, deviceName string, resourceNames []string, start int64, end int64, pageSize int) iter.Seq2[dtos.BaseReading, errors.EdgeX]
 }
@@ -0,0 +1,36 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+	"iter"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// RuleClient defines the interface for interactions with the Rule endpoint on the rules engine service.
+type RuleClient interface {
+	// Add adds a new rule.
+	Add(ctx context.Context, rule dtos.Rule) (common.BaseResponse, errors.EdgeX)
+	// Update updates a rule.
+	Update(ctx context.Context, rule dtos.Rule) (common.BaseResponse, errors.EdgeX)
+	// AllRules returns all rules.
+	// The result can be limited in a certain range by specifying the offset and limit parameters.
+	// offset: The number of items to skip before starting to collect the result set. Default is 0.
+	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
+	AllRules(ctx context.Context, offset int, limit int) (responses.MultiRulesResponse, errors.EdgeX)
+	// IterAllRules iterates over the rules of AllRules, querying pageSize rules at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterAllRules(ctx context.Context, pageSize int) iter.Seq2[dtos.Rule, errors.EdgeX]
+	// RuleByName returns a rule by name.
+	RuleByName(ctx context.Context, name string) (responses.RuleResponse, errors.EdgeX)
+	// DeleteRuleByName deletes a rule by name.
+	DeleteRuleByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX)
+}
@@ -7,7 +7,9 @@ package interfaces
 
 import (
 	"context"
+	"iter"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 )
@@ -16,12 +18,24 @@ import (
 type ScheduleActionRecordClient interface {
 	// AllScheduleActionRecords query schedule action records with start, end, offset, and limit
 	AllScheduleActionRecords(ctx context.Context, start, end int64, offset, limit int) (responses.MultiScheduleActionRecordsResponse, errors.EdgeX)
+	// IterAllScheduleActionRecords iterates over the schedule action records of AllScheduleActionRecords, querying pageSize schedule action records at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterAllScheduleActionRecords(ctx context.Context, start int64, end int64, pageSize int) iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX]
 	// LatestScheduleActionRecordsByJobName query the latest schedule action records by job name
 	LatestScheduleActionRecordsByJobName(ctx context.Context, jobName string) (responses.MultiScheduleActionRecordsResponse, errors.EdgeX)
 	// ScheduleActionRecordsByStatus queries schedule action records with status, start, end, offset, and limit
 	ScheduleActionRecordsByStatus(ctx context.Context, status string, start, end int64, offset, limit int) (responses.MultiScheduleActionRecordsResponse, errors.EdgeX)
+	// IterScheduleActionRecordsByStatus iterates over the schedule action records of ScheduleActionRecordsByStatus, querying pageSize schedule action records at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterScheduleActionRecordsByStatus(ctx context.Context, status string, start int64, end int64, pageSize int) iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX]
 	// ScheduleActionRecordsByJobName query schedule action records with jobName, start, end, offset, and limit
 	ScheduleActionRecordsByJobName(ctx context.Context, jobName string, start, end int64, offset, limit int) (responses.MultiScheduleActionRecordsResponse, errors.EdgeX)
+	// IterScheduleActionRecordsByJobName iterates over the schedule action records of ScheduleActionRecordsByJobName, querying pageSize schedule action records at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterScheduleActionRecordsByJobName(ctx context.Context, jobName string, start int64, end int64, pageSize int) iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX]
 	// ScheduleActionRecordsByJobNameAndStatus query schedule action records with jobName, status, start, end, offset, and limit
 	ScheduleActionRecordsByJobNameAndStatus(ctx context.Context, jobName, status string, start, end int64, offset, limit int) (responses.MultiScheduleActionRecordsResponse, errors.EdgeX)
+	// IterScheduleActionRecordsByJobNameAndStatus iterates over the schedule action records of ScheduleActionRecordsByJobNameAndStatus, querying pageSize schedule action records at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterScheduleActionRecordsByJobNameAndStatus(ctx context.Context, jobName string, status string, start int64, end int64, pageSize int) iter.Seq2[dtos.ScheduleActionRecord, errors.EdgeX]
 }
@@ -7,7 +7,9 @@ package interfaces
 
 import (
 	"context"
+	"iter"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
@@ -22,12 +24,24 @@ type SubscriptionClient interface {
 	Update(ctx context.Context, reqs []requests.UpdateSubscriptionRequest) ([]common.BaseResponse, errors.EdgeX)
 	// AllSubscriptions queries subscriptions with offset and limit
 	AllSubscriptions(ctx context.Context, offset int, limit int) (responses.MultiSubscriptionsResponse, errors.EdgeX)
+	// IterAllSubscriptions iterates over the subscriptions of AllSubscriptions, querying pageSize subscriptions at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterAllSubscriptions(ctx context.Context, pageSize int) iter.Seq2[dtos.Subscription, errors.EdgeX]
 	// SubscriptionsByCategory queries subscriptions with category, offset and limit
 	SubscriptionsByCategory(ctx context.Context, category string, offset int, limit int) (responses.MultiSubscriptionsResponse, errors.EdgeX)
+	// IterSubscriptionsByCategory iterates over the subscriptions of SubscriptionsByCategory, querying pageSize subscriptions at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterSubscriptionsByCategory(ctx context.Context, category string, pageSize int) iter.Seq2[dtos.Subscription, errors.EdgeX]
 	// SubscriptionsByLabel queries subscriptions with label, offset and limit
 	SubscriptionsByLabel(ctx context.Context, label string, offset int, limit int) (responses.MultiSubscriptionsResponse, errors.EdgeX)
+	// IterSubscriptionsByLabel iterates over the subscriptions of SubscriptionsByLabel, querying pageSize subscriptions at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterSubscriptionsByLabel(ctx context.Context, label string, pageSize int) iter.Seq2[dtos.Subscription, errors.EdgeX]
 	// SubscriptionsByReceiver queries subscriptions with receiver, offset and limit
 	SubscriptionsByReceiver(ctx context.Context, receiver string, offset int, limit int) (responses.MultiSubscriptionsResponse, errors.EdgeX)
+	// IterSubscriptionsByReceiver iterates over the subscriptions of SubscriptionsByReceiver, querying pageSize subscriptions at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterSubscriptionsByReceiver(ctx context.Context, receiver string, pageSize int) iter.Seq2[dtos.Subscription, errors.EdgeX]
 	// SubscriptionByName query subscription by name.
 	SubscriptionByName(ctx context.Context, name string) (responses.SubscriptionResponse, errors.EdgeX)
 	// DeleteSubscriptionByName deletes a subscription by name.
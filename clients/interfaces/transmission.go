@@ -7,7 +7,9 @@ package interfaces
 
 import (
 	"context"
+	"iter"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -19,14 +21,29 @@ type TransmissionClient interface {
 	TransmissionById(ctx context.Context, id string) (responses.TransmissionResponse, errors.EdgeX)
 	// TransmissionsByTimeRange query transmissions with time range, offset and limit
 	TransmissionsByTimeRange(ctx context.Context, start, end int64, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX)
+	// IterTransmissionsByTimeRange iterates over the transmissions of TransmissionsByTimeRange, querying pageSize transmissions at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterTransmissionsByTimeRange(ctx context.Context, start int64, end int64, pageSize int) iter.Seq2[dtos.Transmission, errors.EdgeX]
 	// AllTransmissions query transmissions with offset and limit
 	AllTransmissions(ctx context.Context, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX)
+	// IterAllTransmissions iterates over the transmissions of AllTransmissions, querying pageSize transmissions at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterAllTransmissions(ctx context.Context, pageSize int) iter.Seq2[dtos.Transmission, errors.EdgeX]
 	// TransmissionsByStatus queries transmissions with status, offset and limit
 	TransmissionsByStatus(ctx context.Context, status string, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX)
+	// IterTransmissionsByStatus iterates over the transmissions of TransmissionsByStatus, querying pageSize transmissions at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterTransmissionsByStatus(ctx context.Context, status string, pageSize int) iter.Seq2[dtos.Transmission, errors.EdgeX]
 	// DeleteProcessedTransmissionsByAge deletes the processed transmissions if the current timestamp minus their created timestamp is less than the age parameter.
 	DeleteProcessedTransmissionsByAge(ctx context.Context, age int) (common.BaseResponse, errors.EdgeX)
 	// TransmissionsBySubscriptionName query transmissions with subscriptionName, offset and limit
 	TransmissionsBySubscriptionName(ctx context.Context, subscriptionName string, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX)
+	// IterTransmissionsBySubscriptionName iterates over the transmissions of TransmissionsBySubscriptionName, querying pageSize transmissions at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterTransmissionsBySubscriptionName(ctx context.Context, subscriptionName string, pageSize int) iter.Seq2[dtos.Transmission, errors.EdgeX]
 	// TransmissionsByNotificationId query transmissions with notification id, offset and limit
 	TransmissionsByNotificationId(ctx context.Context, id string, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX)
+	// IterTransmissionsByNotificationId iterates over the transmissions of TransmissionsByNotificationId, querying pageSize transmissions at a time until all of them are yielded.
+	// The iteration stops at the first error, e.g. when ctx is canceled.
+	IterTransmissionsByNotificationId(ctx context.Context, id string, pageSize int) iter.Seq2[dtos.Transmission, errors.EdgeX]
 }
@@ -0,0 +1,19 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// UnitsOfMeasureClient defines the interface for interactions with the UnitsOfMeasure endpoint on the EdgeX Foundry core-metadata service.
+type UnitsOfMeasureClient interface {
+	// UnitsOfMeasure returns the units of measure which the device resources are validated against.
+	UnitsOfMeasure(ctx context.Context) (responses.UnitsOfMeasureResponse, errors.EdgeX)
+}
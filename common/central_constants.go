@@ -74,6 +74,13 @@ const (
 	EtherNetIPMajorRevision     = "MajorRevision"
 	EtherNetIPMinorRevision     = "MinorRevision"
 	EtherNetIPAddress           = "Address"
+
+	Canbus         = "CANbus"
+	CanbusNetwork  = "Network"
+	CanbusStandard = "Standard"
+	CanbusID       = "ID"
+	CanbusDataSize = "DataSize"
+	CanbusPort     = "Port"
 )
 
 // constants relate to the remote edge node
@@ -0,0 +1,94 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package common
+
+import "slices"
+
+// The schemas of the protocols supported by the device connectors
+func init() {
+	port := ProtocolPropertySchema{Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0), Maximum: float64Ptr(65535)}
+
+	bacnetProperties := []ProtocolPropertySchema{
+		{Name: BacnetDeviceInstance, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0), Maximum: float64Ptr(4194303), Required: true},
+		{Name: BacnetAddress, Type: ProtocolPropertyTypeString},
+		withName(port, BacnetPort, 47808),
+	}
+	RegisterProtocolSchema(ProtocolSchema{Protocol: BacnetIP, Properties: bacnetProperties})
+	RegisterProtocolSchema(ProtocolSchema{Protocol: BacnetMSTP, Properties: bacnetProperties})
+
+	RegisterProtocolSchema(ProtocolSchema{Protocol: Gps, Properties: []ProtocolPropertySchema{
+		withName(port, GpsGpsdPort, 2947),
+		{Name: GpsGpsdRetries, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0)},
+		{Name: GpsGpsdConnTimeout, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0)},
+		{Name: GpsGpsdRequestTimeout, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0)},
+	}})
+
+	// the maximum quantities per request are defined by the Modbus application protocol specification
+	modbusProperties := []ProtocolPropertySchema{
+		{Name: ModbusAddress, Type: ProtocolPropertyTypeString, Required: true},
+		{Name: ModbusUnitID, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0), Maximum: float64Ptr(255)},
+		{Name: ModbusReadMaxHoldingRegisters, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(1), Maximum: float64Ptr(125)},
+		{Name: ModbusReadMaxInputRegisters, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(1), Maximum: float64Ptr(125)},
+		{Name: ModbusReadMaxBitsCoils, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(1), Maximum: float64Ptr(2000)},
+		{Name: ModbusReadMaxBitsDiscreteInputs, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(1), Maximum: float64Ptr(2000)},
+		{Name: ModbusWriteMaxHoldingRegisters, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(1), Maximum: float64Ptr(123)},
+		{Name: ModbusWriteMaxBitsCoils, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(1), Maximum: float64Ptr(1968)},
+	}
+	RegisterProtocolSchema(ProtocolSchema{Protocol: ModbusTcp, Properties: append(slices.Clone(modbusProperties),
+		withName(port, ModbusPort, 502),
+	)})
+	RegisterProtocolSchema(ProtocolSchema{Protocol: ModbusRtu, Properties: append(slices.Clone(modbusProperties),
+		ProtocolPropertySchema{Name: ModbusBaudRate, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0)},
+		ProtocolPropertySchema{Name: ModbusDataBits, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(5), Maximum: float64Ptr(8)},
+		ProtocolPropertySchema{Name: ModbusStopBits, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(1), Maximum: float64Ptr(2)},
+	)})
+
+	RegisterProtocolSchema(ProtocolSchema{Protocol: Opcua, Properties: []ProtocolPropertySchema{
+		{Name: OpcuaRequestedSessionTimeout, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0)},
+		{Name: OpcuaBrowseDepth, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0)},
+		{Name: OpcuaConnectionReadingPostDelay, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0)},
+		{Name: OpcuaReadBatchSize, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0)},
+		{Name: OpcuaWriteBatchSize, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0)},
+		{Name: OpcuaNodesPerBrowse, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0)},
+		{Name: OpcuaBrowsePublishInterval, Type: ProtocolPropertyTypeFloat, Minimum: float64Ptr(0)},
+		{Name: OpcuaSessionKeepAliveInterval, Type: ProtocolPropertyTypeFloat, Minimum: float64Ptr(0)},
+	}})
+
+	RegisterProtocolSchema(ProtocolSchema{Protocol: S7, Properties: []ProtocolPropertySchema{
+		{Name: S7Rack, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0), Maximum: float64Ptr(7)},
+		{Name: S7Slot, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0), Maximum: float64Ptr(31)},
+	}})
+
+	rpi := ProtocolPropertySchema{Name: EtherNetIPRPI, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0)}
+	RegisterProtocolSchema(ProtocolSchema{Protocol: EtherNetIPExplicitConnected, Properties: []ProtocolPropertySchema{
+		rpi,
+		{Name: EtherNetIPSaveValue, Type: ProtocolPropertyTypeBool},
+	}})
+	RegisterProtocolSchema(ProtocolSchema{Protocol: EtherNetIPO2T, Properties: []ProtocolPropertySchema{rpi}})
+	RegisterProtocolSchema(ProtocolSchema{Protocol: EtherNetIPT2O, Properties: []ProtocolPropertySchema{rpi}})
+	RegisterProtocolSchema(ProtocolSchema{Protocol: EtherNetIPKey, Properties: []ProtocolPropertySchema{
+		{Name: EtherNetIPVendorID, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0), Maximum: float64Ptr(65535)},
+		{Name: EtherNetIPDeviceType, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0), Maximum: float64Ptr(65535)},
+		{Name: EtherNetIPProductCode, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0), Maximum: float64Ptr(65535)},
+		{Name: EtherNetIPMajorRevision, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0), Maximum: float64Ptr(255)},
+		{Name: EtherNetIPMinorRevision, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0), Maximum: float64Ptr(255)},
+	}})
+
+	RegisterProtocolSchema(ProtocolSchema{Protocol: Canbus, Properties: []ProtocolPropertySchema{
+		{Name: CanbusNetwork, Type: ProtocolPropertyTypeString},
+		{Name: CanbusStandard, Type: ProtocolPropertyTypeString},
+		{Name: CanbusID, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0)},
+		{Name: CanbusDataSize, Type: ProtocolPropertyTypeInt, Minimum: float64Ptr(0), Maximum: float64Ptr(64)},
+		withName(port, CanbusPort, nil),
+	}})
+}
+
+func withName(property ProtocolPropertySchema, name string, defaultValue any) ProtocolPropertySchema {
+	property.Name = name
+	property.Default = defaultValue
+	return property
+}
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
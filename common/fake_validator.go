@@ -13,3 +13,7 @@ func Validate(a interface{}) error {
 	return errors.New("wrong build: DTO validator is not available. " +
 		"Build without \"-tags no_dto_validator\" on the go build command line to enable runtime support for this feature")
 }
+
+func ValidateFields(a interface{}) []FieldError {
+	return nil
+}
@@ -0,0 +1,23 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package common
+
+// FieldError is the validation error of a struct field found by ValidateFields
+type FieldError struct {
+	// Namespace is the path of the field from the validated struct, e.g. Device.Protocols
+	Namespace string
+	// Field is the name of the field, e.g. Protocols
+	Field string
+	// Tag is the validation tag which failed, e.g. required
+	Tag string
+	// Param is the parameter of the validation tag, e.g. 1ms of edgex-dto-duration=1ms
+	Param string
+	// Value is the value of the field
+	Value any
+	// Message is the message of the field error in the error returned by Validate
+	Message string
+}
+
+func (e FieldError) Error() string {
+	return e.Message
+}
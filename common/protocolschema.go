@@ -0,0 +1,233 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package common
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// ProtocolPropertyType is the data type of a protocol property value accepted by the device connector
+type ProtocolPropertyType string
+
+const (
+	ProtocolPropertyTypeString ProtocolPropertyType = "String"
+	ProtocolPropertyTypeInt    ProtocolPropertyType = "Int"
+	ProtocolPropertyTypeFloat  ProtocolPropertyType = "Float"
+	ProtocolPropertyTypeBool   ProtocolPropertyType = "Bool"
+)
+
+// ProtocolPropertySchema describes a single protocol property. Minimum and Maximum only apply to Int and Float
+// properties, and a non-empty Enum restricts the property to the listed values.
+type ProtocolPropertySchema struct {
+	Name     string
+	Type     ProtocolPropertyType
+	Minimum  *float64
+	Maximum  *float64
+	Enum     []string
+	Required bool
+	Default  any
+}
+
+// ProtocolSchema describes the properties of a protocol. Properties which are not described are kept as they are.
+type ProtocolSchema struct {
+	Protocol   string
+	Properties []ProtocolPropertySchema
+}
+
+var (
+	protocolSchemasMutex sync.RWMutex
+	protocolSchemas      = make(map[string]ProtocolSchema)
+)
+
+// RegisterProtocolSchema registers the schema for its protocol, replacing any schema registered before
+func RegisterProtocolSchema(schema ProtocolSchema) {
+	protocolSchemasMutex.Lock()
+	defer protocolSchemasMutex.Unlock()
+	protocolSchemas[schema.Protocol] = schema
+}
+
+// LookupProtocolSchema returns the schema registered for the protocol
+func LookupProtocolSchema(protocol string) (ProtocolSchema, bool) {
+	protocolSchemasMutex.RLock()
+	defer protocolSchemasMutex.RUnlock()
+	schema, ok := protocolSchemas[protocol]
+	return schema, ok
+}
+
+// Property returns the schema of the named property
+func (s ProtocolSchema) Property(name string) (ProtocolPropertySchema, bool) {
+	for _, property := range s.Properties {
+		if property.Name == name {
+			return property, true
+		}
+	}
+	return ProtocolPropertySchema{}, false
+}
+
+// PropertiesOfType returns the names of the properties of the given type
+func (s ProtocolSchema) PropertiesOfType(propertyType ProtocolPropertyType) []string {
+	var names []string
+	for _, property := range s.Properties {
+		if property.Type == propertyType {
+			names = append(names, property.Name)
+		}
+	}
+	return names
+}
+
+// ToTypedProperties converts the property values, which are usually strings in EdgeX, to the types of the schema in place
+func (s ProtocolSchema) ToTypedProperties(properties map[string]any) errors.EdgeX {
+	for _, property := range s.Properties {
+		value, ok := properties[property.Name]
+		if !ok {
+			continue
+		}
+		typed, err := property.parse(value)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		properties[property.Name] = typed
+	}
+	return nil
+}
+
+// ToStringProperties converts the property values to the strings stored by EdgeX
+func (s ProtocolSchema) ToStringProperties(properties map[string]any) map[string]string {
+	result := make(map[string]string, len(properties))
+	for name, value := range properties {
+		result[name] = fmt.Sprintf("%v", value)
+		property, ok := s.Property(name)
+		if !ok {
+			continue
+		}
+		number, isFloat := value.(float64)
+		switch {
+		case property.Type == ProtocolPropertyTypeInt && isFloat:
+			// fmt.Sprintf("%v", 4194148.0) is 4.194148e+06, and the dot(.) and plus(+) are invalid for metadata,
+			// so the number is formatted without the decimal point
+			result[name] = fmt.Sprintf("%.0f", number)
+		case property.Type == ProtocolPropertyTypeFloat && isFloat:
+			// print the fewest digits necessary to represent the float, e.g. 5.2 rather than 5.200000
+			result[name] = strconv.FormatFloat(number, 'f', -1, 64)
+		}
+	}
+	return result
+}
+
+// ApplyDefaults sets the properties which are missing to the default of the schema
+func (s ProtocolSchema) ApplyDefaults(properties map[string]any) {
+	for _, property := range s.Properties {
+		if _, ok := properties[property.Name]; !ok && property.Default != nil {
+			properties[property.Name] = property.Default
+		}
+	}
+}
+
+// Validate checks the property values, given either typed or as strings, against the schema
+func (s ProtocolSchema) Validate(properties map[string]any) errors.EdgeX {
+	var errMsg []string
+	for _, property := range s.Properties {
+		if err := s.validateProperty(property, properties[property.Name]); err != nil {
+			errMsg = append(errMsg, err.Message())
+		}
+	}
+	if len(errMsg) > 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, strings.Join(errMsg, "; "), nil)
+	}
+	return nil
+}
+
+// ValidateProperty checks the value of the named property against the schema, where a nil value is a missing property.
+// Properties which are not described by the schema are always valid.
+func (s ProtocolSchema) ValidateProperty(name string, value any) errors.EdgeX {
+	property, ok := s.Property(name)
+	if !ok {
+		return nil
+	}
+	return s.validateProperty(property, value)
+}
+
+func (s ProtocolSchema) validateProperty(property ProtocolPropertySchema, value any) errors.EdgeX {
+	if value == nil || fmt.Sprintf("%v", value) == "" {
+		if property.Required {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s property %s is required", s.Protocol, property.Name), nil)
+		}
+		return nil
+	}
+	if err := property.validate(value); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s property %s: %s", s.Protocol, property.Name, err.Message()), nil)
+	}
+	return nil
+}
+
+// ValidateProtocolProperties validates the properties against the schema registered for the protocol. Protocols
+// without a schema are not validated.
+func ValidateProtocolProperties(protocol string, properties map[string]any) errors.EdgeX {
+	schema, ok := LookupProtocolSchema(protocol)
+	if !ok {
+		return nil
+	}
+	return schema.Validate(properties)
+}
+
+func (p ProtocolPropertySchema) parse(value any) (any, errors.EdgeX) {
+	str := fmt.Sprintf("%v", value)
+	switch p.Type {
+	case ProtocolPropertyTypeInt:
+		if number, ok := value.(float64); ok && number == math.Trunc(number) {
+			str = fmt.Sprintf("%.0f", number)
+		}
+		val, err := strconv.Atoi(str)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to convert %v to int", p.Name), err)
+		}
+		return val, nil
+	case ProtocolPropertyTypeFloat:
+		val, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to convert %v to float", p.Name), err)
+		}
+		return val, nil
+	case ProtocolPropertyTypeBool:
+		val, err := strconv.ParseBool(str)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to convert %v to bool", p.Name), err)
+		}
+		return val, nil
+	}
+	return value, nil
+}
+
+func (p ProtocolPropertySchema) validate(value any) errors.EdgeX {
+	typed, err := p.parse(value)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(p.Enum) > 0 && !slices.Contains(p.Enum, fmt.Sprintf("%v", typed)) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("value '%v' is not one of %v", value, p.Enum), nil)
+	}
+
+	var number float64
+	switch val := typed.(type) {
+	case int:
+		number = float64(val)
+	case float64:
+		number = val
+	default:
+		return nil
+	}
+	if p.Minimum != nil && number < *p.Minimum {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("value %v is less than the minimum %v", value, *p.Minimum), nil)
+	}
+	if p.Maximum != nil && number > *p.Maximum {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("value %v is greater than the maximum %v", value, *p.Maximum), nil)
+	}
+	return nil
+}
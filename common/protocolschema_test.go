@@ -0,0 +1,109 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtocolSchema_Conversion(t *testing.T) {
+	schema, ok := LookupProtocolSchema(Opcua)
+	require.True(t, ok)
+
+	properties := map[string]any{
+		OpcuaRequestedSessionTimeout: "1200000",
+		OpcuaBrowsePublishInterval:   "5.2",
+		OpcuaIDType:                  "1",
+	}
+	require.NoError(t, schema.ToTypedProperties(properties))
+	assert.Equal(t, map[string]any{OpcuaRequestedSessionTimeout: 1200000, OpcuaBrowsePublishInterval: 5.2, OpcuaIDType: "1"}, properties)
+
+	// the XRT properties are decoded from JSON, so the numbers are float64
+	xrtProperties := map[string]any{
+		OpcuaRequestedSessionTimeout: float64(4194148),
+		OpcuaBrowsePublishInterval:   5.2,
+		OpcuaIDType:                  float64(1),
+	}
+	assert.Equal(t, map[string]string{OpcuaRequestedSessionTimeout: "4194148", OpcuaBrowsePublishInterval: "5.2", OpcuaIDType: "1"},
+		schema.ToStringProperties(xrtProperties))
+
+	assert.Error(t, schema.ToTypedProperties(map[string]any{OpcuaBrowseDepth: "deep"}))
+}
+
+func TestProtocolSchema_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		protocol    string
+		properties  map[string]any
+		expectedErr bool
+	}{
+		{"valid strings", ModbusTcp, map[string]any{ModbusAddress: "127.0.0.1", ModbusPort: "502", ModbusUnitID: "1"}, false},
+		{"valid typed", ModbusRtu, map[string]any{ModbusAddress: "/dev/ttyS0", ModbusDataBits: 8, ModbusStopBits: float64(1)}, false},
+		{"unknown protocol", "foo", map[string]any{"Port": "bar"}, false},
+		{"missing required", ModbusTcp, map[string]any{ModbusPort: "502"}, true},
+		{"empty required", BacnetIP, map[string]any{BacnetDeviceInstance: ""}, true},
+		{"wrong type", EtherNetIPExplicitConnected, map[string]any{EtherNetIPSaveValue: "yes"}, true},
+		{"below minimum", ModbusRtu, map[string]any{ModbusAddress: "/dev/ttyS0", ModbusDataBits: "4"}, true},
+		{"above maximum", BacnetIP, map[string]any{BacnetDeviceInstance: "4194304"}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ValidateProtocolProperties(testCase.protocol, testCase.properties)
+			if testCase.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProtocolSchema_ValidateProperty(t *testing.T) {
+	schema, ok := LookupProtocolSchema(ModbusRtu)
+	require.True(t, ok)
+
+	tests := []struct {
+		name        string
+		property    string
+		value       any
+		expectedErr bool
+	}{
+		{"valid", ModbusDataBits, "8", false},
+		{"undescribed property", "foo", "bar", false},
+		{"missing optional", ModbusDataBits, nil, false},
+		{"missing required", ModbusAddress, nil, true},
+		{"empty required", ModbusAddress, "", true},
+		{"below minimum", ModbusDataBits, "4", true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := schema.ValidateProperty(testCase.property, testCase.value)
+			if testCase.expectedErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Message(), testCase.property)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRegisterProtocolSchema(t *testing.T) {
+	maximum := 10.0
+	RegisterProtocolSchema(ProtocolSchema{Protocol: "test-protocol", Properties: []ProtocolPropertySchema{
+		{Name: "Mode", Type: ProtocolPropertyTypeString, Enum: []string{"fast", "slow"}, Default: "fast"},
+		{Name: "Level", Type: ProtocolPropertyTypeInt, Maximum: &maximum, Default: 1},
+	}})
+	schema, ok := LookupProtocolSchema("test-protocol")
+	require.True(t, ok)
+
+	properties := map[string]any{"Level": "5"}
+	schema.ApplyDefaults(properties)
+	assert.Equal(t, map[string]any{"Mode": "fast", "Level": "5"}, properties)
+	assert.NoError(t, schema.Validate(properties))
+	assert.Error(t, schema.Validate(map[string]any{"Mode": "medium"}))
+	assert.Error(t, schema.Validate(map[string]any{"Level": 11}))
+}
@@ -8,6 +8,7 @@ package common
 import (
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -26,6 +27,11 @@ var valueTypes = []string{
 	ValueTypeObject, ValueTypeObjectArray,
 }
 
+// ValueTypes returns the value types accepted by the edgex-dto-value-type validation tag
+func ValueTypes() []string {
+	return slices.Clone(valueTypes)
+}
+
 // NormalizeValueType normalizes the valueType to upper camel case
 func NormalizeValueType(valueType string) (string, error) {
 	for _, v := range valueTypes {
@@ -93,6 +93,26 @@ func Validate(a interface{}) error {
 	return nil
 }
 
+// ValidateFields validates the struct as Validate does, and returns the error of each invalid field
+func ValidateFields(a interface{}) []FieldError {
+	errs, ok := val.Struct(a).(validator.ValidationErrors)
+	if !ok {
+		return nil
+	}
+	fieldErrors := make([]FieldError, len(errs))
+	for i, e := range errs {
+		fieldErrors[i] = FieldError{
+			Namespace: e.StructNamespace(),
+			Field:     e.StructField(),
+			Tag:       e.Tag(),
+			Param:     e.Param(),
+			Value:     e.Value(),
+			Message:   getErrorMessage(e),
+		}
+	}
+	return fieldErrors
+}
+
 // Internal: generate representative validation error messages
 func getErrorMessage(e validator.FieldError) string {
 	tag := e.Tag()
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
 
@@ -48,6 +49,17 @@ type UpdateDevice struct {
 	Properties     map[string]any                `json:"properties"`
 }
 
+// ValidateProtocols validates the protocol properties against the schemas registered in common, the protocols
+// without a schema are not validated
+func (d Device) ValidateProtocols() error {
+	for protocol, properties := range d.Protocols {
+		if err := common.ValidateProtocolProperties(protocol, properties); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid %s protocol properties of device %s", protocol, d.Name), err)
+		}
+	}
+	return nil
+}
+
 // ToDeviceModel transforms the Device DTO to the Device Model
 func ToDeviceModel(dto Device) models.Device {
 	var d models.Device
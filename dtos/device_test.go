@@ -8,6 +8,7 @@ package dtos
 import (
 	"testing"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
 	"github.com/stretchr/testify/assert"
@@ -95,3 +96,21 @@ func TestFromDeviceModelToUpdateDTO(t *testing.T) {
 	dto := FromDeviceModelToUpdateDTO(model)
 	assert.Equal(t, testUpdateDto, dto)
 }
+
+func TestDeviceValidateProtocols(t *testing.T) {
+	device := Device{Name: testName, Protocols: map[string]ProtocolProperties{
+		common.ModbusTcp: {common.ModbusAddress: "127.0.0.1", common.ModbusPort: "502"},
+		"other":          {"Port": "any"},
+	}}
+	assert.NoError(t, device.ValidateProtocols())
+
+	device.Protocols[common.ModbusTcp][common.ModbusPort] = "65536"
+	assert.Error(t, device.ValidateProtocols())
+
+	canDevice := Device{Name: testName, Protocols: map[string]ProtocolProperties{
+		common.Canbus: {common.CanbusNetwork: "can0", common.CanbusID: "256", common.CanbusDataSize: "8"},
+	}}
+	assert.NoError(t, canDevice.ValidateProtocols())
+	canDevice.Protocols[common.Canbus][common.CanbusDataSize] = "65"
+	assert.Error(t, canDevice.ValidateProtocols())
+}
@@ -0,0 +1,321 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sparkplug
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/sparkplug/protobuf"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// EncodeEvent converts the EdgeX Event to a Sparkplug B payload with one metric per reading.
+// The payload sequence number and uuid are taken from the SeqTag and UuidTag event tags when present.
+func EncodeEvent(event dtos.Event) (*protobuf.Payload, error) {
+	payload := &protobuf.Payload{
+		Timestamp: proto.Uint64(toMillis(event.Origin)),
+		Metrics:   make([]*protobuf.Payload_Metric, 0, len(event.Readings)),
+	}
+
+	if seqTag, ok := event.Tags[SeqTag]; ok {
+		seq, err := parseSeq(seqTag)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid %s tag of event %s", SeqTag, event.Id), err)
+		}
+		payload.Seq = proto.Uint64(seq)
+	}
+	if uuid, ok := event.Tags[UuidTag]; ok {
+		payload.Uuid = proto.String(fmt.Sprint(uuid))
+	}
+
+	for _, reading := range event.Readings {
+		metric, err := ReadingToMetric(reading)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to encode reading %s of event %s", reading.ResourceName, event.Id), err)
+		}
+		payload.Metrics = append(payload.Metrics, metric)
+	}
+
+	return payload, nil
+}
+
+// DecodePayload converts the Sparkplug B payload to an EdgeX Event with one reading per metric.
+// Metrics which only carry an alias, as in NDATA and DDATA messages, are named by looking up the
+// aliases map, which can be nil when every metric carries its name.
+func DecodePayload(payload *protobuf.Payload, profileName, deviceName, sourceName string, aliases map[uint64]string) (dtos.Event, error) {
+	event := dtos.NewEvent(profileName, deviceName, sourceName)
+	if payload == nil {
+		return event, errors.NewCommonEdgeX(errors.KindContractInvalid, "payload is nil", nil)
+	}
+	if payload.Timestamp != nil {
+		event.Origin = toNanos(payload.GetTimestamp())
+	}
+	if payload.Seq != nil || payload.Uuid != nil {
+		event.Tags = make(dtos.Tags)
+		if payload.Seq != nil {
+			event.Tags[SeqTag] = payload.GetSeq()
+		}
+		if payload.Uuid != nil {
+			event.Tags[UuidTag] = payload.GetUuid()
+		}
+	}
+
+	event.Readings = make([]dtos.BaseReading, 0, len(payload.GetMetrics()))
+	for _, metric := range payload.GetMetrics() {
+		name := metric.GetName()
+		if name == "" && metric.Alias != nil {
+			name = aliases[metric.GetAlias()]
+		}
+		if name == "" {
+			return event, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("metric with alias %d has no name", metric.GetAlias()), nil)
+		}
+
+		reading, err := MetricToReading(metric, profileName, deviceName, name)
+		if err != nil {
+			return event, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to decode metric %s", name), err)
+		}
+		if metric.Timestamp != nil {
+			reading.Origin = toNanos(metric.GetTimestamp())
+		} else {
+			reading.Origin = event.Origin
+		}
+		event.Readings = append(event.Readings, reading)
+	}
+
+	return event, nil
+}
+
+// ReadingToMetric converts the EdgeX reading to a Sparkplug B metric named after the resource
+func ReadingToMetric(reading dtos.BaseReading) (*protobuf.Payload_Metric, errors.EdgeX) {
+	dataType, err := DataTypeFromValueType(reading.ValueType)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	metric := &protobuf.Payload_Metric{
+		Name:     proto.String(reading.ResourceName),
+		Datatype: proto.Uint32(uint32(dataType)),
+	}
+	if reading.Origin > 0 {
+		metric.Timestamp = proto.Uint64(toMillis(reading.Origin))
+	}
+	if reading.Units != "" {
+		metric.Properties = &protobuf.Payload_PropertySet{
+			Keys:   []string{PropertyEngUnit},
+			Values: []*protobuf.Payload_PropertyValue{stringPropertyValue(reading.Units)},
+		}
+	}
+
+	if reading.IsNull() {
+		metric.IsNull = proto.Bool(true)
+		return metric, nil
+	}
+
+	switch dataType {
+	case protobuf.DataType_Bytes:
+		metric.Value = &protobuf.Payload_Metric_BytesValue{BytesValue: reading.BinaryValue}
+		if reading.MediaType != "" {
+			metric.Metadata = &protobuf.Payload_MetaData{ContentType: proto.String(reading.MediaType)}
+		}
+	case protobuf.DataType_DataSet:
+		dataSet, err := ObjectToDataSet(reading.ObjectValue)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		metric.Value = &protobuf.Payload_Metric_DatasetValue{DatasetValue: dataSet}
+	default:
+		value, err := common.ParseValueByDeviceResource(reading.ValueType, reading.Value)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		metric.Value, err = toMetricValue(dataType, value)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	return metric, nil
+}
+
+// MetricToReading converts the Sparkplug B metric to an EdgeX reading of the named resource.
+// The reading origin is taken from the metric timestamp when present.
+func MetricToReading(metric *protobuf.Payload_Metric, profileName, deviceName, resourceName string) (dtos.BaseReading, errors.EdgeX) {
+	dataType := protobuf.DataType(metric.GetDatatype())
+	valueType, err := ValueTypeFromDataType(dataType)
+	if err != nil {
+		return dtos.BaseReading{}, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	var reading dtos.BaseReading
+	switch {
+	case metric.GetIsNull():
+		reading = dtos.NewNullReading(profileName, deviceName, resourceName, valueType)
+	case valueType == common.ValueTypeBinary:
+		reading = dtos.NewBinaryReading(profileName, deviceName, resourceName, metric.GetBytesValue(), metric.GetMetadata().GetContentType())
+	case valueType == common.ValueTypeObject:
+		rows, err := DataSetToObject(metric.GetDatasetValue())
+		if err != nil {
+			return dtos.BaseReading{}, errors.NewCommonEdgeXWrapper(err)
+		}
+		reading = dtos.NewObjectReading(profileName, deviceName, resourceName, rows)
+	default:
+		value, err := fromMetricValue(dataType, metric)
+		if err != nil {
+			return dtos.BaseReading{}, errors.NewCommonEdgeXWrapper(err)
+		}
+		var readingErr error
+		reading, readingErr = dtos.NewSimpleReading(profileName, deviceName, resourceName, valueType, value)
+		if readingErr != nil {
+			return dtos.BaseReading{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to create simple reading", readingErr)
+		}
+	}
+
+	if metric.Timestamp != nil {
+		reading.Origin = toNanos(metric.GetTimestamp())
+	}
+	if units, ok := propertyValue(metric.GetProperties(), PropertyEngUnit); ok {
+		reading.Units = units.GetStringValue()
+	}
+	return reading, nil
+}
+
+// ObjectToDataSet converts the object reading value to a DataSet. A map becomes a single row and a
+// slice of maps becomes one row per element, with the columns sorted by name and the column types
+// inferred from the first non-nil value in each column.
+func ObjectToDataSet(object any) (*protobuf.Payload_DataSet, errors.EdgeX) {
+	if dataSet, ok := object.(*protobuf.Payload_DataSet); ok {
+		return dataSet, nil
+	}
+
+	var rows []map[string]any
+	switch v := object.(type) {
+	case map[string]any:
+		rows = []map[string]any{v}
+	case []map[string]any:
+		rows = v
+	case []any:
+		rows = make([]map[string]any, len(v))
+		for i, element := range v {
+			row, ok := element.(map[string]any)
+			if !ok {
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("object array element '%v' is not an object", element), nil)
+			}
+			rows[i] = row
+		}
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("object value of type %T cannot be converted to a DataSet", object), nil)
+	}
+
+	var columns []string
+	for _, row := range rows {
+		for column := range row {
+			if !slices.Contains(columns, column) {
+				columns = append(columns, column)
+			}
+		}
+	}
+	sort.Strings(columns)
+
+	dataSet := &protobuf.Payload_DataSet{
+		NumOfColumns: proto.Uint64(uint64(len(columns))),
+		Columns:      columns,
+		Types:        make([]uint32, len(columns)),
+		Rows:         make([]*protobuf.Payload_DataSet_Row, len(rows)),
+	}
+	for i, row := range rows {
+		dataSet.Rows[i] = &protobuf.Payload_DataSet_Row{Elements: make([]*protobuf.Payload_DataSet_DataSetValue, len(columns))}
+		for j, column := range columns {
+			value, dataType, err := toDataSetValue(row[column])
+			if err != nil {
+				return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("invalid value of column %s", column), err)
+			}
+			if dataType != protobuf.DataType_Unknown {
+				if dataSet.Types[j] == uint32(protobuf.DataType_Unknown) {
+					dataSet.Types[j] = uint32(dataType)
+				} else if dataSet.Types[j] != uint32(dataType) {
+					return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("column %s mixes %s and %s values", column, protobuf.DataType(dataSet.Types[j]), dataType), nil)
+				}
+			}
+			dataSet.Rows[i].Elements[j] = value
+		}
+	}
+	for j, column := range columns {
+		if dataSet.Types[j] == uint32(protobuf.DataType_Unknown) {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the type of column %s cannot be inferred from null values", column), nil)
+		}
+	}
+
+	return dataSet, nil
+}
+
+// DataSetToObject converts the DataSet to a slice of rows keyed by column name
+func DataSetToObject(dataSet *protobuf.Payload_DataSet) ([]map[string]any, errors.EdgeX) {
+	columns := dataSet.GetColumns()
+	types := dataSet.GetTypes()
+	if len(types) != len(columns) {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DataSet has %d columns but %d types", len(columns), len(types)), nil)
+	}
+
+	rows := make([]map[string]any, len(dataSet.GetRows()))
+	for i, row := range dataSet.GetRows() {
+		if len(row.GetElements()) != len(columns) {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DataSet row %d has %d elements but %d columns", i, len(row.GetElements()), len(columns)), nil)
+		}
+		rows[i] = make(map[string]any, len(columns))
+		for j, element := range row.GetElements() {
+			value, err := fromDataSetValue(protobuf.DataType(types[j]), element)
+			if err != nil {
+				return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("invalid value of column %s", columns[j]), err)
+			}
+			rows[i][columns[j]] = value
+		}
+	}
+	return rows, nil
+}
+
+func parseSeq(value any) (uint64, error) {
+	var seq uint64
+	var err error
+	switch v := value.(type) {
+	case uint64:
+		seq = v
+	case float64:
+		// the tag is a JSON number after the event went through the message bus
+		seq = uint64(v)
+	default:
+		seq, err = strconv.ParseUint(fmt.Sprint(v), 10, 64)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if seq > MaxSeq {
+		return 0, fmt.Errorf("sequence number %d is out of range [0, %d]", seq, MaxSeq)
+	}
+	return seq, nil
+}
+
+func stringPropertyValue(value string) *protobuf.Payload_PropertyValue {
+	return &protobuf.Payload_PropertyValue{
+		Type:  proto.Uint32(uint32(protobuf.DataType_String)),
+		Value: &protobuf.Payload_PropertyValue_StringValue{StringValue: value},
+	}
+}
+
+func propertyValue(properties *protobuf.Payload_PropertySet, key string) (*protobuf.Payload_PropertyValue, bool) {
+	for i, k := range properties.GetKeys() {
+		if k == key && i < len(properties.GetValues()) {
+			return properties.GetValues()[i], true
+		}
+	}
+	return nil, false
+}
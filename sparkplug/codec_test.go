@@ -0,0 +1,190 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sparkplug
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/sparkplug/protobuf"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	testProfileName = "test-profile"
+	testDeviceName  = "test-device"
+	testSourceName  = "test-source"
+)
+
+func TestEncodeDecodeEvent(t *testing.T) {
+	tests := []struct {
+		name             string
+		valueType        string
+		value            any
+		expectedDataType protobuf.DataType
+	}{
+		{"Bool", common.ValueTypeBool, true, protobuf.DataType_Boolean},
+		{"String", common.ValueTypeString, "foo", protobuf.DataType_String},
+		{"Uint8", common.ValueTypeUint8, uint8(255), protobuf.DataType_UInt8},
+		{"Uint16", common.ValueTypeUint16, uint16(65535), protobuf.DataType_UInt16},
+		{"Uint32", common.ValueTypeUint32, uint32(4294967295), protobuf.DataType_UInt32},
+		{"Uint64", common.ValueTypeUint64, uint64(18446744073709551615), protobuf.DataType_UInt64},
+		{"Int8", common.ValueTypeInt8, int8(-128), protobuf.DataType_Int8},
+		{"Int16", common.ValueTypeInt16, int16(-32768), protobuf.DataType_Int16},
+		{"Int32", common.ValueTypeInt32, int32(-2147483648), protobuf.DataType_Int32},
+		{"Int64", common.ValueTypeInt64, int64(-9223372036854775808), protobuf.DataType_Int64},
+		{"Float32", common.ValueTypeFloat32, float32(1.5), protobuf.DataType_Float},
+		{"Float64", common.ValueTypeFloat64, float64(-2.25), protobuf.DataType_Double},
+		{"BoolArray", common.ValueTypeBoolArray, []bool{true, false, true, true, false, false, false, false, true}, protobuf.DataType_BooleanArray},
+		{"StringArray", common.ValueTypeStringArray, []string{"foo", "", "bar"}, protobuf.DataType_StringArray},
+		{"Uint8Array", common.ValueTypeUint8Array, []uint8{0, 1, 255}, protobuf.DataType_UInt8Array},
+		{"Uint16Array", common.ValueTypeUint16Array, []uint16{0, 1, 65535}, protobuf.DataType_UInt16Array},
+		{"Uint32Array", common.ValueTypeUint32Array, []uint32{0, 1, 4294967295}, protobuf.DataType_UInt32Array},
+		{"Uint64Array", common.ValueTypeUint64Array, []uint64{0, 1, 18446744073709551615}, protobuf.DataType_UInt64Array},
+		{"Int8Array", common.ValueTypeInt8Array, []int8{-128, 0, 127}, protobuf.DataType_Int8Array},
+		{"Int16Array", common.ValueTypeInt16Array, []int16{-32768, 0, 32767}, protobuf.DataType_Int16Array},
+		{"Int32Array", common.ValueTypeInt32Array, []int32{-2147483648, 0, 2147483647}, protobuf.DataType_Int32Array},
+		{"Int64Array", common.ValueTypeInt64Array, []int64{-9223372036854775808, 0, 9223372036854775807}, protobuf.DataType_Int64Array},
+		{"Float32Array", common.ValueTypeFloat32Array, []float32{-1.5, 0, 2.25}, protobuf.DataType_FloatArray},
+		{"Float64Array", common.ValueTypeFloat64Array, []float64{-1.5, 0, 2.25}, protobuf.DataType_DoubleArray},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			event := dtos.NewEvent(testProfileName, testDeviceName, testSourceName)
+			err := event.AddSimpleReading(testCase.name, testCase.valueType, testCase.value)
+			require.NoError(t, err)
+			event.Readings[0].Units = "degC"
+
+			payload, err := EncodeEvent(event)
+			require.NoError(t, err)
+			require.Len(t, payload.GetMetrics(), 1)
+			assert.Equal(t, uint32(testCase.expectedDataType), payload.GetMetrics()[0].GetDatatype())
+
+			// round trip through the wire format
+			data, err := proto.Marshal(payload)
+			require.NoError(t, err)
+			decodedPayload := &protobuf.Payload{}
+			require.NoError(t, proto.Unmarshal(data, decodedPayload))
+
+			result, err := DecodePayload(decodedPayload, testProfileName, testDeviceName, testSourceName, nil)
+			require.NoError(t, err)
+			require.Len(t, result.Readings, 1)
+			assert.Equal(t, event.Readings[0].ValueType, result.Readings[0].ValueType)
+			assert.Equal(t, event.Readings[0].Value, result.Readings[0].Value)
+			assert.Equal(t, event.Readings[0].Units, result.Readings[0].Units)
+			assert.Equal(t, event.Readings[0].Origin/nanosPerMilli, result.Readings[0].Origin/nanosPerMilli)
+			assert.Equal(t, event.Origin/nanosPerMilli, result.Origin/nanosPerMilli)
+		})
+	}
+}
+
+func TestEncodeDecodeEvent_BinaryObjectAndNull(t *testing.T) {
+	event := dtos.NewEvent(testProfileName, testDeviceName, testSourceName)
+	event.AddBinaryReading("binary", []byte{0x01, 0x02, 0x03}, common.ContentTypeCBOR)
+	event.AddObjectReading("object", []map[string]any{
+		{"id": int32(1), "name": "foo", "value": 1.5},
+		{"id": int32(2), "name": "bar", "value": 2.5},
+	})
+	event.AddNullReading("null", common.ValueTypeInt32)
+	event.Tags = dtos.Tags{SeqTag: uint64(7)}
+
+	payload, err := EncodeEvent(event)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), payload.GetSeq())
+	require.Len(t, payload.GetMetrics(), 3)
+	assert.Equal(t, uint32(protobuf.DataType_Bytes), payload.GetMetrics()[0].GetDatatype())
+	assert.Equal(t, uint32(protobuf.DataType_DataSet), payload.GetMetrics()[1].GetDatatype())
+	assert.Equal(t, []string{"id", "name", "value"}, payload.GetMetrics()[1].GetDatasetValue().GetColumns())
+	assert.Equal(t, []uint32{uint32(protobuf.DataType_Int32), uint32(protobuf.DataType_String), uint32(protobuf.DataType_Double)},
+		payload.GetMetrics()[1].GetDatasetValue().GetTypes())
+	assert.True(t, payload.GetMetrics()[2].GetIsNull())
+
+	result, err := DecodePayload(payload, testProfileName, testDeviceName, testSourceName, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), result.Tags[SeqTag])
+	require.Len(t, result.Readings, 3)
+	assert.Equal(t, common.ValueTypeBinary, result.Readings[0].ValueType)
+	assert.Equal(t, []byte{0x01, 0x02, 0x03}, result.Readings[0].BinaryValue)
+	assert.Equal(t, common.ContentTypeCBOR, result.Readings[0].MediaType)
+	assert.Equal(t, common.ValueTypeObject, result.Readings[1].ValueType)
+	assert.Equal(t, event.Readings[1].ObjectValue, result.Readings[1].ObjectValue)
+	assert.Equal(t, common.ValueTypeInt32, result.Readings[2].ValueType)
+	assert.True(t, result.Readings[2].IsNull())
+}
+
+func TestDecodePayload_Alias(t *testing.T) {
+	payload := &protobuf.Payload{
+		Timestamp: proto.Uint64(1700000000000),
+		Seq:       proto.Uint64(3),
+		Metrics: []*protobuf.Payload_Metric{
+			{
+				Alias:    proto.Uint64(1),
+				Datatype: proto.Uint32(uint32(protobuf.DataType_Double)),
+				Value:    &protobuf.Payload_Metric_DoubleValue{DoubleValue: 20.5},
+			},
+		},
+	}
+
+	result, err := DecodePayload(payload, testProfileName, testDeviceName, testSourceName, map[uint64]string{1: "temperature"})
+	require.NoError(t, err)
+	require.Len(t, result.Readings, 1)
+	assert.Equal(t, "temperature", result.Readings[0].ResourceName)
+	assert.Equal(t, int64(1700000000000)*nanosPerMilli, result.Readings[0].Origin)
+
+	_, err = DecodePayload(payload, testProfileName, testDeviceName, testSourceName, nil)
+	assert.Error(t, err)
+}
+
+func TestEncodeEvent_Error(t *testing.T) {
+	invalidSeq := dtos.NewEvent(testProfileName, testDeviceName, testSourceName)
+	require.NoError(t, invalidSeq.AddSimpleReading("r", common.ValueTypeBool, true))
+	invalidSeq.Tags = dtos.Tags{SeqTag: 256}
+
+	mixedColumn := dtos.NewEvent(testProfileName, testDeviceName, testSourceName)
+	mixedColumn.AddObjectReading("r", []any{map[string]any{"a": 1.5}, map[string]any{"a": "foo"}})
+
+	invalidObject := dtos.NewEvent(testProfileName, testDeviceName, testSourceName)
+	invalidObject.AddObjectReading("r", "foo")
+
+	tests := []struct {
+		name  string
+		event dtos.Event
+	}{
+		{"invalid sequence number", invalidSeq},
+		{"mixed column types", mixedColumn},
+		{"object is not a DataSet", invalidObject},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := EncodeEvent(testCase.event)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestDecodeArray_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		dataType protobuf.DataType
+		data     []byte
+	}{
+		{"bool array without count", protobuf.DataType_BooleanArray, []byte{0x01}},
+		{"bool array too short", protobuf.DataType_BooleanArray, []byte{0x09, 0, 0, 0, 0xFF}},
+		{"string array not terminated", protobuf.DataType_StringArray, []byte("foo")},
+		{"partial int32", protobuf.DataType_Int32Array, []byte{0x01, 0x02, 0x03}},
+		{"not an array", protobuf.DataType_Template, []byte{}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := decodeArray(testCase.dataType, testCase.data)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sparkplug
+
+const (
+	// SeqTag is the Event tag that carries the Sparkplug payload sequence number
+	SeqTag = "sparkplugSeq"
+	// UuidTag is the Event tag that carries the Sparkplug payload uuid
+	UuidTag = "sparkplugUuid"
+
+	// PropertyEngUnit is the metric property that carries the reading units
+	PropertyEngUnit = "engUnit"
+	// PropertyEngLow is the metric property that carries the resource minimum
+	PropertyEngLow = "engLow"
+	// PropertyEngHigh is the metric property that carries the resource maximum
+	PropertyEngHigh = "engHigh"
+
+	// MaxSeq is the largest sequence number before it wraps back to zero
+	MaxSeq = 255
+
+	nanosPerMilli = int64(1000000)
+)
+
+const (
+	// BdSeqMetric is the NBIRTH and NDEATH metric that pairs a birth certificate with its death certificate
+	BdSeqMetric = "bdSeq"
+	// RebirthMetric is the NBIRTH metric a host application writes with an NCMD to request a rebirth
+	RebirthMetric = "Node Control/Rebirth"
+)
+
+// Template parameter names, resource level parameters are prefixed with the resource name and TemplateParameterSeparator
+const (
+	TemplateParameterSeparator = "."
+
+	ParameterDescription    = "description"
+	ParameterManufacturer   = "manufacturer"
+	ParameterModel          = "model"
+	ParameterLabels         = "labels"
+	ParameterApiVersion     = "apiVersion"
+	ParameterDeviceCommands = "deviceCommands"
+	ParameterIsHidden       = "isHidden"
+	ParameterTag            = "tag"
+	ParameterAttributes     = "attributes"
+	ParameterTags           = "tags"
+	ParameterValueType      = "valueType"
+	ParameterReadWrite      = "readWrite"
+	ParameterUnits          = "units"
+	ParameterMinimum        = "minimum"
+	ParameterMaximum        = "maximum"
+	ParameterScale          = "scale"
+	ParameterOffset         = "offset"
+	ParameterDefaultValue   = "defaultValue"
+	ParameterMask           = "mask"
+	ParameterShift          = "shift"
+	ParameterBase           = "base"
+	ParameterAssertion      = "assertion"
+	ParameterMediaType      = "mediaType"
+	ParameterOptional       = "optional"
+)
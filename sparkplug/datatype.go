@@ -0,0 +1,416 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sparkplug
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/sparkplug/protobuf"
+)
+
+// ValueTypeFromDataType returns the EdgeX value type that a Sparkplug metric datatype is decoded to
+func ValueTypeFromDataType(dataType protobuf.DataType) (string, errors.EdgeX) {
+	switch dataType {
+	case protobuf.DataType_Int8:
+		return common.ValueTypeInt8, nil
+	case protobuf.DataType_Int16:
+		return common.ValueTypeInt16, nil
+	case protobuf.DataType_Int32:
+		return common.ValueTypeInt32, nil
+	case protobuf.DataType_Int64, protobuf.DataType_DateTime:
+		return common.ValueTypeInt64, nil
+	case protobuf.DataType_UInt8:
+		return common.ValueTypeUint8, nil
+	case protobuf.DataType_UInt16:
+		return common.ValueTypeUint16, nil
+	case protobuf.DataType_UInt32:
+		return common.ValueTypeUint32, nil
+	case protobuf.DataType_UInt64:
+		return common.ValueTypeUint64, nil
+	case protobuf.DataType_Float:
+		return common.ValueTypeFloat32, nil
+	case protobuf.DataType_Double:
+		return common.ValueTypeFloat64, nil
+	case protobuf.DataType_Boolean:
+		return common.ValueTypeBool, nil
+	case protobuf.DataType_String, protobuf.DataType_Text, protobuf.DataType_UUID:
+		return common.ValueTypeString, nil
+	case protobuf.DataType_DataSet:
+		return common.ValueTypeObject, nil
+	case protobuf.DataType_Bytes, protobuf.DataType_File:
+		return common.ValueTypeBinary, nil
+	case protobuf.DataType_Int8Array:
+		return common.ValueTypeInt8Array, nil
+	case protobuf.DataType_Int16Array:
+		return common.ValueTypeInt16Array, nil
+	case protobuf.DataType_Int32Array:
+		return common.ValueTypeInt32Array, nil
+	case protobuf.DataType_Int64Array, protobuf.DataType_DateTimeArray:
+		return common.ValueTypeInt64Array, nil
+	case protobuf.DataType_UInt8Array:
+		return common.ValueTypeUint8Array, nil
+	case protobuf.DataType_UInt16Array:
+		return common.ValueTypeUint16Array, nil
+	case protobuf.DataType_UInt32Array:
+		return common.ValueTypeUint32Array, nil
+	case protobuf.DataType_UInt64Array:
+		return common.ValueTypeUint64Array, nil
+	case protobuf.DataType_FloatArray:
+		return common.ValueTypeFloat32Array, nil
+	case protobuf.DataType_DoubleArray:
+		return common.ValueTypeFloat64Array, nil
+	case protobuf.DataType_BooleanArray:
+		return common.ValueTypeBoolArray, nil
+	case protobuf.DataType_StringArray:
+		return common.ValueTypeStringArray, nil
+	default:
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported Sparkplug datatype '%s'", dataType), nil)
+	}
+}
+
+// DataTypeFromValueType returns the Sparkplug metric datatype that an EdgeX value type is encoded to
+func DataTypeFromValueType(valueType string) (protobuf.DataType, errors.EdgeX) {
+	normalized, err := common.NormalizeValueType(valueType)
+	if err != nil {
+		return protobuf.DataType_Unknown, errors.NewCommonEdgeXWrapper(err)
+	}
+	switch normalized {
+	case common.ValueTypeInt8:
+		return protobuf.DataType_Int8, nil
+	case common.ValueTypeInt16:
+		return protobuf.DataType_Int16, nil
+	case common.ValueTypeInt32:
+		return protobuf.DataType_Int32, nil
+	case common.ValueTypeInt64:
+		return protobuf.DataType_Int64, nil
+	case common.ValueTypeUint8:
+		return protobuf.DataType_UInt8, nil
+	case common.ValueTypeUint16:
+		return protobuf.DataType_UInt16, nil
+	case common.ValueTypeUint32:
+		return protobuf.DataType_UInt32, nil
+	case common.ValueTypeUint64:
+		return protobuf.DataType_UInt64, nil
+	case common.ValueTypeFloat32:
+		return protobuf.DataType_Float, nil
+	case common.ValueTypeFloat64:
+		return protobuf.DataType_Double, nil
+	case common.ValueTypeBool:
+		return protobuf.DataType_Boolean, nil
+	case common.ValueTypeString:
+		return protobuf.DataType_String, nil
+	case common.ValueTypeObject, common.ValueTypeObjectArray:
+		return protobuf.DataType_DataSet, nil
+	case common.ValueTypeBinary:
+		return protobuf.DataType_Bytes, nil
+	case common.ValueTypeInt8Array:
+		return protobuf.DataType_Int8Array, nil
+	case common.ValueTypeInt16Array:
+		return protobuf.DataType_Int16Array, nil
+	case common.ValueTypeInt32Array:
+		return protobuf.DataType_Int32Array, nil
+	case common.ValueTypeInt64Array:
+		return protobuf.DataType_Int64Array, nil
+	case common.ValueTypeUint8Array:
+		return protobuf.DataType_UInt8Array, nil
+	case common.ValueTypeUint16Array:
+		return protobuf.DataType_UInt16Array, nil
+	case common.ValueTypeUint32Array:
+		return protobuf.DataType_UInt32Array, nil
+	case common.ValueTypeUint64Array:
+		return protobuf.DataType_UInt64Array, nil
+	case common.ValueTypeFloat32Array:
+		return protobuf.DataType_FloatArray, nil
+	case common.ValueTypeFloat64Array:
+		return protobuf.DataType_DoubleArray, nil
+	case common.ValueTypeBoolArray:
+		return protobuf.DataType_BooleanArray, nil
+	case common.ValueTypeStringArray:
+		return protobuf.DataType_StringArray, nil
+	default:
+		return protobuf.DataType_Unknown, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported value type '%s'", valueType), nil)
+	}
+}
+
+// toMetricValue converts the typed Go value parsed by common.ParseValueByDeviceResource to the metric value
+func toMetricValue(dataType protobuf.DataType, value any) (protobuf.IsPayload_Metric_Value, errors.EdgeX) {
+	switch v := value.(type) {
+	case int8:
+		return &protobuf.Payload_Metric_IntValue{IntValue: uint32(int32(v))}, nil
+	case int16:
+		return &protobuf.Payload_Metric_IntValue{IntValue: uint32(int32(v))}, nil
+	case int32:
+		return &protobuf.Payload_Metric_IntValue{IntValue: uint32(v)}, nil
+	case int64:
+		return &protobuf.Payload_Metric_LongValue{LongValue: uint64(v)}, nil
+	case uint8:
+		return &protobuf.Payload_Metric_IntValue{IntValue: uint32(v)}, nil
+	case uint16:
+		return &protobuf.Payload_Metric_IntValue{IntValue: uint32(v)}, nil
+	case uint32:
+		return &protobuf.Payload_Metric_IntValue{IntValue: v}, nil
+	case uint64:
+		return &protobuf.Payload_Metric_LongValue{LongValue: v}, nil
+	case float32:
+		return &protobuf.Payload_Metric_FloatValue{FloatValue: v}, nil
+	case float64:
+		return &protobuf.Payload_Metric_DoubleValue{DoubleValue: v}, nil
+	case bool:
+		return &protobuf.Payload_Metric_BooleanValue{BooleanValue: v}, nil
+	case string:
+		return &protobuf.Payload_Metric_StringValue{StringValue: v}, nil
+	}
+
+	data, err := encodeArray(dataType, value)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return &protobuf.Payload_Metric_BytesValue{BytesValue: data}, nil
+}
+
+// fromMetricValue converts the metric value to the typed Go value accepted by dtos.NewSimpleReading
+func fromMetricValue(dataType protobuf.DataType, metric *protobuf.Payload_Metric) (any, errors.EdgeX) {
+	switch dataType {
+	case protobuf.DataType_Int8:
+		return int8(int32(metric.GetIntValue())), nil
+	case protobuf.DataType_Int16:
+		return int16(int32(metric.GetIntValue())), nil
+	case protobuf.DataType_Int32:
+		return int32(metric.GetIntValue()), nil
+	case protobuf.DataType_Int64, protobuf.DataType_DateTime:
+		return int64(metric.GetLongValue()), nil
+	case protobuf.DataType_UInt8:
+		return uint8(metric.GetIntValue()), nil
+	case protobuf.DataType_UInt16:
+		return uint16(metric.GetIntValue()), nil
+	case protobuf.DataType_UInt32:
+		return metric.GetIntValue(), nil
+	case protobuf.DataType_UInt64:
+		return metric.GetLongValue(), nil
+	case protobuf.DataType_Float:
+		return metric.GetFloatValue(), nil
+	case protobuf.DataType_Double:
+		return metric.GetDoubleValue(), nil
+	case protobuf.DataType_Boolean:
+		return metric.GetBooleanValue(), nil
+	case protobuf.DataType_String, protobuf.DataType_Text, protobuf.DataType_UUID:
+		return metric.GetStringValue(), nil
+	default:
+		return decodeArray(dataType, metric.GetBytesValue())
+	}
+}
+
+// encodeArray packs the array value into the Sparkplug B array encoding, which is little endian for
+// numeric arrays, a bit-packed sequence prefixed with the element count for boolean arrays, and
+// null terminated UTF-8 strings for string arrays
+func encodeArray(dataType protobuf.DataType, value any) ([]byte, errors.EdgeX) {
+	buf := new(bytes.Buffer)
+	switch dataType {
+	case protobuf.DataType_BooleanArray:
+		arr, ok := value.([]bool)
+		if !ok {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid bool array '%v'", value), nil)
+		}
+		packed := make([]byte, 4+(len(arr)+7)/8)
+		binary.LittleEndian.PutUint32(packed, uint32(len(arr)))
+		for i, b := range arr {
+			if b {
+				packed[4+i/8] |= 1 << (7 - uint(i%8))
+			}
+		}
+		return packed, nil
+	case protobuf.DataType_StringArray:
+		arr, ok := value.([]string)
+		if !ok {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid string array '%v'", value), nil)
+		}
+		for _, s := range arr {
+			if strings.IndexByte(s, 0) >= 0 {
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("string array element '%s' contains a null character", s), nil)
+			}
+			buf.WriteString(s)
+			buf.WriteByte(0)
+		}
+		return buf.Bytes(), nil
+	case protobuf.DataType_Int8Array, protobuf.DataType_Int16Array, protobuf.DataType_Int32Array, protobuf.DataType_Int64Array,
+		protobuf.DataType_UInt8Array, protobuf.DataType_UInt16Array, protobuf.DataType_UInt32Array, protobuf.DataType_UInt64Array,
+		protobuf.DataType_FloatArray, protobuf.DataType_DoubleArray, protobuf.DataType_DateTimeArray:
+		if err := binary.Write(buf, binary.LittleEndian, value); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to encode '%v' as %s", value, dataType), err)
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported Sparkplug datatype '%s'", dataType), nil)
+	}
+}
+
+// decodeArray unpacks the Sparkplug B array encoding, see encodeArray
+func decodeArray(dataType protobuf.DataType, data []byte) (any, errors.EdgeX) {
+	var arr any
+	var size int
+	switch dataType {
+	case protobuf.DataType_BooleanArray:
+		if len(data) < 4 {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "bool array is missing the element count", nil)
+		}
+		count := binary.LittleEndian.Uint32(data)
+		if uint64(len(data)-4)*8 < uint64(count) {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("bool array of %d bytes cannot hold %d elements", len(data), count), nil)
+		}
+		bools := make([]bool, count)
+		for i := range bools {
+			bools[i] = data[4+i/8]&(1<<(7-uint(i%8))) != 0
+		}
+		return bools, nil
+	case protobuf.DataType_StringArray:
+		strs := []string{}
+		if len(data) == 0 {
+			return strs, nil
+		}
+		if data[len(data)-1] != 0 {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "string array is not null terminated", nil)
+		}
+		for _, s := range bytes.Split(data[:len(data)-1], []byte{0}) {
+			strs = append(strs, string(s))
+		}
+		return strs, nil
+	case protobuf.DataType_Int8Array:
+		size = 1
+		arr = make([]int8, len(data)/size)
+	case protobuf.DataType_Int16Array:
+		size = 2
+		arr = make([]int16, len(data)/size)
+	case protobuf.DataType_Int32Array:
+		size = 4
+		arr = make([]int32, len(data)/size)
+	case protobuf.DataType_Int64Array, protobuf.DataType_DateTimeArray:
+		size = 8
+		arr = make([]int64, len(data)/size)
+	case protobuf.DataType_UInt8Array:
+		size = 1
+		arr = make([]uint8, len(data)/size)
+	case protobuf.DataType_UInt16Array:
+		size = 2
+		arr = make([]uint16, len(data)/size)
+	case protobuf.DataType_UInt32Array:
+		size = 4
+		arr = make([]uint32, len(data)/size)
+	case protobuf.DataType_UInt64Array:
+		size = 8
+		arr = make([]uint64, len(data)/size)
+	case protobuf.DataType_FloatArray:
+		size = 4
+		arr = make([]float32, len(data)/size)
+	case protobuf.DataType_DoubleArray:
+		size = 8
+		arr = make([]float64, len(data)/size)
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported Sparkplug datatype '%s'", dataType), nil)
+	}
+
+	if len(data)%size != 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%d bytes is not a whole number of %s elements", len(data), dataType), nil)
+	}
+	if err := binary.Read(bytes.NewReader(data), binary.LittleEndian, arr); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to decode %s", dataType), err)
+	}
+	return arr, nil
+}
+
+// toDataSetValue converts a Go value to a DataSet cell and returns the column datatype it implies
+func toDataSetValue(value any) (*protobuf.Payload_DataSet_DataSetValue, protobuf.DataType, errors.EdgeX) {
+	switch v := value.(type) {
+	case nil:
+		return &protobuf.Payload_DataSet_DataSetValue{}, protobuf.DataType_Unknown, nil
+	case int8:
+		return &protobuf.Payload_DataSet_DataSetValue{Value: &protobuf.Payload_DataSet_DataSetValue_IntValue{IntValue: uint32(int32(v))}}, protobuf.DataType_Int8, nil
+	case int16:
+		return &protobuf.Payload_DataSet_DataSetValue{Value: &protobuf.Payload_DataSet_DataSetValue_IntValue{IntValue: uint32(int32(v))}}, protobuf.DataType_Int16, nil
+	case int32:
+		return &protobuf.Payload_DataSet_DataSetValue{Value: &protobuf.Payload_DataSet_DataSetValue_IntValue{IntValue: uint32(v)}}, protobuf.DataType_Int32, nil
+	case int:
+		return &protobuf.Payload_DataSet_DataSetValue{Value: &protobuf.Payload_DataSet_DataSetValue_LongValue{LongValue: uint64(v)}}, protobuf.DataType_Int64, nil
+	case int64:
+		return &protobuf.Payload_DataSet_DataSetValue{Value: &protobuf.Payload_DataSet_DataSetValue_LongValue{LongValue: uint64(v)}}, protobuf.DataType_Int64, nil
+	case uint8:
+		return &protobuf.Payload_DataSet_DataSetValue{Value: &protobuf.Payload_DataSet_DataSetValue_IntValue{IntValue: uint32(v)}}, protobuf.DataType_UInt8, nil
+	case uint16:
+		return &protobuf.Payload_DataSet_DataSetValue{Value: &protobuf.Payload_DataSet_DataSetValue_IntValue{IntValue: uint32(v)}}, protobuf.DataType_UInt16, nil
+	case uint32:
+		return &protobuf.Payload_DataSet_DataSetValue{Value: &protobuf.Payload_DataSet_DataSetValue_IntValue{IntValue: v}}, protobuf.DataType_UInt32, nil
+	case uint:
+		return &protobuf.Payload_DataSet_DataSetValue{Value: &protobuf.Payload_DataSet_DataSetValue_LongValue{LongValue: uint64(v)}}, protobuf.DataType_UInt64, nil
+	case uint64:
+		return &protobuf.Payload_DataSet_DataSetValue{Value: &protobuf.Payload_DataSet_DataSetValue_LongValue{LongValue: v}}, protobuf.DataType_UInt64, nil
+	case float32:
+		return &protobuf.Payload_DataSet_DataSetValue{Value: &protobuf.Payload_DataSet_DataSetValue_FloatValue{FloatValue: v}}, protobuf.DataType_Float, nil
+	case float64:
+		return &protobuf.Payload_DataSet_DataSetValue{Value: &protobuf.Payload_DataSet_DataSetValue_DoubleValue{DoubleValue: v}}, protobuf.DataType_Double, nil
+	case bool:
+		return &protobuf.Payload_DataSet_DataSetValue{Value: &protobuf.Payload_DataSet_DataSetValue_BooleanValue{BooleanValue: v}}, protobuf.DataType_Boolean, nil
+	case string:
+		return &protobuf.Payload_DataSet_DataSetValue{Value: &protobuf.Payload_DataSet_DataSetValue_StringValue{StringValue: v}}, protobuf.DataType_String, nil
+	default:
+		return nil, protobuf.DataType_Unknown, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported DataSet value '%v' of type %T", value, value), nil)
+	}
+}
+
+// fromDataSetValue converts a DataSet cell of the given column datatype to a Go value
+func fromDataSetValue(dataType protobuf.DataType, value *protobuf.Payload_DataSet_DataSetValue) (any, errors.EdgeX) {
+	if value.GetValue() == nil {
+		return nil, nil
+	}
+	switch dataType {
+	case protobuf.DataType_Int8:
+		return int8(int32(value.GetIntValue())), nil
+	case protobuf.DataType_Int16:
+		return int16(int32(value.GetIntValue())), nil
+	case protobuf.DataType_Int32:
+		return int32(value.GetIntValue()), nil
+	case protobuf.DataType_Int64, protobuf.DataType_DateTime:
+		return int64(value.GetLongValue()), nil
+	case protobuf.DataType_UInt8:
+		return uint8(value.GetIntValue()), nil
+	case protobuf.DataType_UInt16:
+		return uint16(value.GetIntValue()), nil
+	case protobuf.DataType_UInt32:
+		return value.GetIntValue(), nil
+	case protobuf.DataType_UInt64:
+		return value.GetLongValue(), nil
+	case protobuf.DataType_Float:
+		return value.GetFloatValue(), nil
+	case protobuf.DataType_Double:
+		return value.GetDoubleValue(), nil
+	case protobuf.DataType_Boolean:
+		return value.GetBooleanValue(), nil
+	case protobuf.DataType_String, protobuf.DataType_Text, protobuf.DataType_UUID:
+		return value.GetStringValue(), nil
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported DataSet column datatype '%s'", dataType), nil)
+	}
+}
+
+// toMillis converts the EdgeX nanosecond timestamp to the Sparkplug millisecond timestamp
+func toMillis(nanos int64) uint64 {
+	if nanos <= 0 {
+		return 0
+	}
+	return uint64(nanos / nanosPerMilli)
+}
+
+// toNanos converts the Sparkplug millisecond timestamp to the EdgeX nanosecond timestamp
+func toNanos(millis uint64) int64 {
+	if millis > math.MaxInt64/uint64(nanosPerMilli) {
+		return math.MaxInt64
+	}
+	return int64(millis) * nanosPerMilli
+}
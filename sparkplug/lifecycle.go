@@ -0,0 +1,160 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sparkplug
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/sparkplug/protobuf"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// NewNodeBirth creates the NBIRTH payload, which always has seq 0 and carries the bdSeq and
+// Node Control/Rebirth metrics ahead of the given edge node metrics
+func NewNodeBirth(bdSeq uint64, metrics ...*protobuf.Payload_Metric) *protobuf.Payload {
+	return &protobuf.Payload{
+		Timestamp: proto.Uint64(uint64(time.Now().UnixMilli())),
+		Seq:       proto.Uint64(0),
+		Metrics: append([]*protobuf.Payload_Metric{
+			bdSeqMetric(bdSeq),
+			{
+				Name:     proto.String(RebirthMetric),
+				Datatype: proto.Uint32(uint32(protobuf.DataType_Boolean)),
+				Value:    &protobuf.Payload_Metric_BooleanValue{BooleanValue: false},
+			},
+		}, metrics...),
+	}
+}
+
+// NewNodeDeath creates the NDEATH payload, usually registered as the MQTT will message, which only
+// carries the bdSeq metric of the matching NBIRTH
+func NewNodeDeath(bdSeq uint64) *protobuf.Payload {
+	return &protobuf.Payload{
+		Timestamp: proto.Uint64(uint64(time.Now().UnixMilli())),
+		Metrics:   []*protobuf.Payload_Metric{bdSeqMetric(bdSeq)},
+	}
+}
+
+// NewDeviceBirth creates the DBIRTH payload of the device, with one metric per profile DeviceResource.
+// Aliases are assigned in resource order from firstAlias, and the caller must keep them unique across
+// every device of the edge node. The returned alias map can be passed to DecodePayload for later DDATA.
+func NewDeviceBirth(profile dtos.DeviceProfile, device dtos.Device, seq uint64, firstAlias uint64) (*protobuf.Payload, map[uint64]string, errors.EdgeX) {
+	if device.ProfileName != profile.Name {
+		return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device %s uses profile %s rather than %s", device.Name, device.ProfileName, profile.Name), nil)
+	}
+	if err := validateSeq(seq); err != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	payload := &protobuf.Payload{
+		Timestamp: proto.Uint64(uint64(time.Now().UnixMilli())),
+		Seq:       proto.Uint64(seq),
+		Metrics:   make([]*protobuf.Payload_Metric, 0, len(profile.DeviceResources)),
+	}
+	aliases := make(map[uint64]string, len(profile.DeviceResources))
+	for i, resource := range profile.DeviceResources {
+		alias := firstAlias + uint64(i)
+		metric, err := ResourceToMetric(resource, alias)
+		if err != nil {
+			return nil, nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to create the birth metric of resource %s", resource.Name), err)
+		}
+		payload.Metrics = append(payload.Metrics, metric)
+		aliases[alias] = resource.Name
+	}
+
+	return payload, aliases, nil
+}
+
+// NewDeviceDeath creates the DDEATH payload, which only carries the timestamp and seq
+func NewDeviceDeath(seq uint64) (*protobuf.Payload, errors.EdgeX) {
+	if err := validateSeq(seq); err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return &protobuf.Payload{
+		Timestamp: proto.Uint64(uint64(time.Now().UnixMilli())),
+		Seq:       proto.Uint64(seq),
+	}, nil
+}
+
+// validateSeq returns an error if the sequence number is out of the range of the Sparkplug seq
+func validateSeq(seq uint64) errors.EdgeX {
+	if seq > MaxSeq {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("sequence number %d is out of range [0, %d]", seq, MaxSeq), nil)
+	}
+	return nil
+}
+
+// ResourceToMetric creates the birth metric of the DeviceResource. The Units, Minimum and Maximum
+// properties become the engUnit, engLow and engHigh metric properties, and the value is the resource
+// DefaultValue, or null when there is none.
+func ResourceToMetric(resource dtos.DeviceResource, alias uint64) (*protobuf.Payload_Metric, errors.EdgeX) {
+	dataType, err := DataTypeFromValueType(resource.Properties.ValueType)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	metric := &protobuf.Payload_Metric{
+		Name:      proto.String(resource.Name),
+		Alias:     proto.Uint64(alias),
+		Timestamp: proto.Uint64(uint64(time.Now().UnixMilli())),
+		Datatype:  proto.Uint32(uint32(dataType)),
+	}
+
+	properties := &protobuf.Payload_PropertySet{}
+	if resource.Properties.Units != "" {
+		properties.Keys = append(properties.Keys, PropertyEngUnit)
+		properties.Values = append(properties.Values, stringPropertyValue(resource.Properties.Units))
+	}
+	if resource.Properties.Minimum != nil {
+		properties.Keys = append(properties.Keys, PropertyEngLow)
+		properties.Values = append(properties.Values, doublePropertyValue(*resource.Properties.Minimum))
+	}
+	if resource.Properties.Maximum != nil {
+		properties.Keys = append(properties.Keys, PropertyEngHigh)
+		properties.Values = append(properties.Values, doublePropertyValue(*resource.Properties.Maximum))
+	}
+	if len(properties.Keys) > 0 {
+		metric.Properties = properties
+	}
+
+	switch {
+	case resource.Properties.DefaultValue == "",
+		dataType == protobuf.DataType_Bytes,
+		dataType == protobuf.DataType_DataSet:
+		metric.IsNull = proto.Bool(true)
+	default:
+		value, err := common.ParseValueByDeviceResource(resource.Properties.ValueType, resource.Properties.DefaultValue)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("invalid default value '%s'", resource.Properties.DefaultValue), err)
+		}
+		metric.Value, err = toMetricValue(dataType, value)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	return metric, nil
+}
+
+func bdSeqMetric(bdSeq uint64) *protobuf.Payload_Metric {
+	return &protobuf.Payload_Metric{
+		Name:     proto.String(BdSeqMetric),
+		Datatype: proto.Uint32(uint32(protobuf.DataType_Int64)),
+		Value:    &protobuf.Payload_Metric_LongValue{LongValue: bdSeq % (MaxSeq + 1)},
+	}
+}
+
+func doublePropertyValue(value float64) *protobuf.Payload_PropertyValue {
+	return &protobuf.Payload_PropertyValue{
+		Type:  proto.Uint32(uint32(protobuf.DataType_Double)),
+		Value: &protobuf.Payload_PropertyValue_DoubleValue{DoubleValue: value},
+	}
+}
@@ -0,0 +1,135 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sparkplug
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/sparkplug/protobuf"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testProfile() dtos.DeviceProfile {
+	minimum := float64(-40)
+	maximum := float64(125)
+	return dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: testProfileName},
+		DeviceResources: []dtos.DeviceResource{
+			{
+				Name: "temperature",
+				Properties: dtos.ResourceProperties{
+					ValueType: common.ValueTypeFloat64,
+					ReadWrite: common.ReadWrite_R,
+					Units:     "degC",
+					Minimum:   &minimum,
+					Maximum:   &maximum,
+				},
+			},
+			{
+				Name: "enabled",
+				Properties: dtos.ResourceProperties{
+					ValueType:    common.ValueTypeBool,
+					ReadWrite:    common.ReadWrite_RW,
+					DefaultValue: "true",
+				},
+			},
+		},
+	}
+}
+
+func TestNewDeviceBirth(t *testing.T) {
+	device := dtos.Device{Name: testDeviceName, ProfileName: testProfileName}
+
+	payload, aliases, err := NewDeviceBirth(testProfile(), device, 5, 10)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), payload.GetSeq())
+	assert.Equal(t, map[uint64]string{10: "temperature", 11: "enabled"}, aliases)
+	require.Len(t, payload.GetMetrics(), 2)
+
+	temperature := payload.GetMetrics()[0]
+	assert.Equal(t, "temperature", temperature.GetName())
+	assert.Equal(t, uint64(10), temperature.GetAlias())
+	assert.Equal(t, uint32(protobuf.DataType_Double), temperature.GetDatatype())
+	assert.True(t, temperature.GetIsNull())
+	assert.Equal(t, []string{PropertyEngUnit, PropertyEngLow, PropertyEngHigh}, temperature.GetProperties().GetKeys())
+	assert.Equal(t, "degC", temperature.GetProperties().GetValues()[0].GetStringValue())
+	assert.Equal(t, float64(-40), temperature.GetProperties().GetValues()[1].GetDoubleValue())
+	assert.Equal(t, float64(125), temperature.GetProperties().GetValues()[2].GetDoubleValue())
+
+	enabled := payload.GetMetrics()[1]
+	assert.Equal(t, uint64(11), enabled.GetAlias())
+	assert.False(t, enabled.GetIsNull())
+	assert.True(t, enabled.GetBooleanValue())
+	assert.Nil(t, enabled.GetProperties())
+
+	// the birth certificate decodes back to readings of the device
+	event, decodeErr := DecodePayload(payload, testProfileName, testDeviceName, testSourceName, aliases)
+	require.NoError(t, decodeErr)
+	require.Len(t, event.Readings, 2)
+	assert.True(t, event.Readings[0].IsNull())
+	assert.Equal(t, "degC", event.Readings[0].Units)
+	assert.Equal(t, "true", event.Readings[1].Value)
+}
+
+func TestNewDeviceBirth_Error(t *testing.T) {
+	invalidDefault := testProfile()
+	invalidDefault.DeviceResources[1].Properties.DefaultValue = "foo"
+
+	tests := []struct {
+		name    string
+		profile dtos.DeviceProfile
+		device  dtos.Device
+		seq     uint64
+	}{
+		{"profile mismatch", testProfile(), dtos.Device{Name: testDeviceName, ProfileName: "other"}, 0},
+		{"seq out of range", testProfile(), dtos.Device{Name: testDeviceName, ProfileName: testProfileName}, 256},
+		{"invalid default value", invalidDefault, dtos.Device{Name: testDeviceName, ProfileName: testProfileName}, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, _, err := NewDeviceBirth(testCase.profile, testCase.device, testCase.seq, 1)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNodeBirthAndDeath(t *testing.T) {
+	birth := NewNodeBirth(3)
+	assert.Equal(t, uint64(0), birth.GetSeq())
+	require.Len(t, birth.GetMetrics(), 2)
+	assert.Equal(t, BdSeqMetric, birth.GetMetrics()[0].GetName())
+	assert.Equal(t, uint64(3), birth.GetMetrics()[0].GetLongValue())
+	assert.Equal(t, RebirthMetric, birth.GetMetrics()[1].GetName())
+
+	death := NewNodeDeath(3)
+	assert.Nil(t, death.Seq)
+	require.Len(t, death.GetMetrics(), 1)
+	assert.Equal(t, uint64(3), death.GetMetrics()[0].GetLongValue())
+
+	deviceDeath, err := NewDeviceDeath(9)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(9), deviceDeath.GetSeq())
+	assert.Empty(t, deviceDeath.GetMetrics())
+
+	_, err = NewDeviceDeath(MaxSeq + 1)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestSequence(t *testing.T) {
+	seq := NewSequence(254)
+	assert.Equal(t, uint64(254), seq.Next())
+	assert.Equal(t, uint64(255), seq.Next())
+	assert.Equal(t, uint64(0), seq.Next())
+	assert.Equal(t, uint64(1), seq.Current())
+	seq.Reset()
+	assert.Equal(t, uint64(0), seq.Next())
+}
@@ -0,0 +1,43 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sparkplug
+
+import "sync"
+
+// Sequence is a goroutine safe counter for the payload seq and the bdSeq metric, both of which
+// run from 0 to 255 and then wrap back to 0
+type Sequence struct {
+	mutex sync.Mutex
+	value uint64
+}
+
+// NewSequence creates a Sequence whose first Next call returns start
+func NewSequence(start uint64) *Sequence {
+	return &Sequence{value: start % (MaxSeq + 1)}
+}
+
+// Next returns the current value and advances the counter
+func (s *Sequence) Next() uint64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	value := s.value
+	s.value = (s.value + 1) % (MaxSeq + 1)
+	return value
+}
+
+// Current returns the value the next Next call will return
+func (s *Sequence) Current() uint64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.value
+}
+
+// Reset sets the counter back to 0, as required for the seq of every NBIRTH
+func (s *Sequence) Reset() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.value = 0
+}
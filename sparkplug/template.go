@@ -0,0 +1,337 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sparkplug
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/sparkplug/protobuf"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ProfileToTemplate converts the device profile to a Template definition metric named after the profile, as
+// published in an NBIRTH. Each DeviceResource becomes a template member metric, and the other profile and resource
+// fields are carried as template parameters, see TemplateParameterSeparator. The profile Labels and DeviceCommands, and
+// the resource Attributes, Tags and Optional property are carried as JSON string parameters, so TemplateToProfile
+// decodes their values as the JSON of the DTO is decoded, e.g. the numbers come back as float64. Only the Id and
+// DBTimestamp assigned by core-metadata are not carried.
+func ProfileToTemplate(profile dtos.DeviceProfile) (*protobuf.Payload_Metric, errors.EdgeX) {
+	template := &protobuf.Payload_Template{
+		IsDefinition: proto.Bool(true),
+		Metrics:      make([]*protobuf.Payload_Metric, 0, len(profile.DeviceResources)),
+	}
+	template.Parameters = appendStringParameter(template.Parameters, ParameterDescription, profile.Description)
+	template.Parameters = appendStringParameter(template.Parameters, ParameterManufacturer, profile.Manufacturer)
+	template.Parameters = appendStringParameter(template.Parameters, ParameterModel, profile.Model)
+	template.Parameters = appendStringParameter(template.Parameters, ParameterApiVersion, profile.ApiVersion)
+	var err errors.EdgeX
+	if len(profile.Labels) > 0 {
+		template.Parameters, err = appendJSONParameter(template.Parameters, ParameterLabels, profile.Labels)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if len(profile.DeviceCommands) > 0 {
+		template.Parameters, err = appendJSONParameter(template.Parameters, ParameterDeviceCommands, profile.DeviceCommands)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	for _, resource := range profile.DeviceResources {
+		dataType, err := DataTypeFromValueType(resource.Properties.ValueType)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to convert resource %s", resource.Name), err)
+		}
+		metric := &protobuf.Payload_Metric{
+			Name:     proto.String(resource.Name),
+			Datatype: proto.Uint32(uint32(dataType)),
+			IsNull:   proto.Bool(true),
+		}
+		if resource.Description != "" {
+			metric.Metadata = &protobuf.Payload_MetaData{Description: proto.String(resource.Description)}
+		}
+		template.Metrics = append(template.Metrics, metric)
+
+		if resource.IsHidden {
+			template.Parameters = append(template.Parameters, &protobuf.Payload_Template_Parameter{
+				Name:  proto.String(resourceParameter(resource.Name, ParameterIsHidden)),
+				Type:  proto.Uint32(uint32(protobuf.DataType_Boolean)),
+				Value: &protobuf.Payload_Template_Parameter_BooleanValue{BooleanValue: true},
+			})
+		}
+		template.Parameters = appendStringParameter(template.Parameters, resourceParameter(resource.Name, ParameterTag), resource.Tag)
+		if len(resource.Attributes) > 0 {
+			template.Parameters, err = appendJSONParameter(template.Parameters, resourceParameter(resource.Name, ParameterAttributes), resource.Attributes)
+			if err != nil {
+				return nil, errors.NewCommonEdgeXWrapper(err)
+			}
+		}
+		if len(resource.Tags) > 0 {
+			template.Parameters, err = appendJSONParameter(template.Parameters, resourceParameter(resource.Name, ParameterTags), resource.Tags)
+			if err != nil {
+				return nil, errors.NewCommonEdgeXWrapper(err)
+			}
+		}
+
+		properties := resource.Properties
+		template.Parameters = appendStringParameter(template.Parameters, resourceParameter(resource.Name, ParameterValueType), properties.ValueType)
+		template.Parameters = appendStringParameter(template.Parameters, resourceParameter(resource.Name, ParameterReadWrite), properties.ReadWrite)
+		template.Parameters = appendStringParameter(template.Parameters, resourceParameter(resource.Name, ParameterUnits), properties.Units)
+		template.Parameters = appendDoubleParameter(template.Parameters, resourceParameter(resource.Name, ParameterMinimum), properties.Minimum)
+		template.Parameters = appendDoubleParameter(template.Parameters, resourceParameter(resource.Name, ParameterMaximum), properties.Maximum)
+		template.Parameters = appendDoubleParameter(template.Parameters, resourceParameter(resource.Name, ParameterScale), properties.Scale)
+		template.Parameters = appendDoubleParameter(template.Parameters, resourceParameter(resource.Name, ParameterOffset), properties.Offset)
+		template.Parameters = appendStringParameter(template.Parameters, resourceParameter(resource.Name, ParameterDefaultValue), properties.DefaultValue)
+		template.Parameters = appendUInt64Parameter(template.Parameters, resourceParameter(resource.Name, ParameterMask), properties.Mask)
+		template.Parameters = appendInt64Parameter(template.Parameters, resourceParameter(resource.Name, ParameterShift), properties.Shift)
+		template.Parameters = appendDoubleParameter(template.Parameters, resourceParameter(resource.Name, ParameterBase), properties.Base)
+		template.Parameters = appendStringParameter(template.Parameters, resourceParameter(resource.Name, ParameterAssertion), properties.Assertion)
+		template.Parameters = appendStringParameter(template.Parameters, resourceParameter(resource.Name, ParameterMediaType), properties.MediaType)
+		if len(properties.Optional) > 0 {
+			template.Parameters, err = appendJSONParameter(template.Parameters, resourceParameter(resource.Name, ParameterOptional), properties.Optional)
+			if err != nil {
+				return nil, errors.NewCommonEdgeXWrapper(err)
+			}
+		}
+	}
+
+	return &protobuf.Payload_Metric{
+		Name:     proto.String(profile.Name),
+		Datatype: proto.Uint32(uint32(protobuf.DataType_Template)),
+		Value:    &protobuf.Payload_Metric_TemplateValue{TemplateValue: template},
+	}, nil
+}
+
+// TemplateToProfile converts a Template definition metric created by ProfileToTemplate back to the device profile
+func TemplateToProfile(metric *protobuf.Payload_Metric) (dtos.DeviceProfile, errors.EdgeX) {
+	template := metric.GetTemplateValue()
+	if template == nil || protobuf.DataType(metric.GetDatatype()) != protobuf.DataType_Template {
+		return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("metric %s is not a Template", metric.GetName()), nil)
+	}
+	if !template.GetIsDefinition() {
+		return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("template %s is an instance rather than a definition", metric.GetName()), nil)
+	}
+
+	profile := dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: metric.GetName()},
+		DeviceResources:        make([]dtos.DeviceResource, len(template.GetMetrics())),
+	}
+	resourceIndex := make(map[string]int, len(template.GetMetrics()))
+	for i, m := range template.GetMetrics() {
+		profile.DeviceResources[i] = dtos.DeviceResource{
+			Name:        m.GetName(),
+			Description: m.GetMetadata().GetDescription(),
+		}
+		resourceIndex[m.GetName()] = i
+	}
+
+	for _, parameter := range template.GetParameters() {
+		switch parameter.GetName() {
+		case ParameterDescription:
+			profile.Description = parameter.GetStringValue()
+			continue
+		case ParameterManufacturer:
+			profile.Manufacturer = parameter.GetStringValue()
+			continue
+		case ParameterModel:
+			profile.Model = parameter.GetStringValue()
+			continue
+		case ParameterApiVersion:
+			profile.ApiVersion = parameter.GetStringValue()
+			continue
+		case ParameterLabels:
+			if err := decodeJSONParameter(parameter, &profile.Labels); err != nil {
+				return dtos.DeviceProfile{}, errors.NewCommonEdgeXWrapper(err)
+			}
+			continue
+		case ParameterDeviceCommands:
+			if err := decodeJSONParameter(parameter, &profile.DeviceCommands); err != nil {
+				return dtos.DeviceProfile{}, errors.NewCommonEdgeXWrapper(err)
+			}
+			continue
+		}
+
+		separator := strings.LastIndex(parameter.GetName(), TemplateParameterSeparator)
+		if separator < 0 {
+			// parameters added by other Sparkplug tooling are not part of the profile
+			continue
+		}
+		i, ok := resourceIndex[parameter.GetName()[:separator]]
+		if !ok {
+			return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("template parameter %s does not belong to a template member", parameter.GetName()), nil)
+		}
+		resource := &profile.DeviceResources[i]
+		properties := &resource.Properties
+		switch parameter.GetName()[separator+len(TemplateParameterSeparator):] {
+		case ParameterIsHidden:
+			resource.IsHidden = parameter.GetBooleanValue()
+		case ParameterTag:
+			resource.Tag = parameter.GetStringValue()
+		case ParameterAttributes:
+			if err := decodeJSONParameter(parameter, &resource.Attributes); err != nil {
+				return dtos.DeviceProfile{}, errors.NewCommonEdgeXWrapper(err)
+			}
+		case ParameterTags:
+			if err := decodeJSONParameter(parameter, &resource.Tags); err != nil {
+				return dtos.DeviceProfile{}, errors.NewCommonEdgeXWrapper(err)
+			}
+		case ParameterValueType:
+			properties.ValueType = parameter.GetStringValue()
+		case ParameterReadWrite:
+			properties.ReadWrite = parameter.GetStringValue()
+		case ParameterUnits:
+			properties.Units = parameter.GetStringValue()
+		case ParameterMinimum:
+			properties.Minimum = doubleParameterValue(parameter)
+		case ParameterMaximum:
+			properties.Maximum = doubleParameterValue(parameter)
+		case ParameterScale:
+			properties.Scale = doubleParameterValue(parameter)
+		case ParameterOffset:
+			properties.Offset = doubleParameterValue(parameter)
+		case ParameterDefaultValue:
+			properties.DefaultValue = parameter.GetStringValue()
+		case ParameterMask:
+			if v, ok := parameter.GetValue().(*protobuf.Payload_Template_Parameter_LongValue); ok {
+				properties.Mask = &v.LongValue
+			}
+		case ParameterShift:
+			if v, ok := parameter.GetValue().(*protobuf.Payload_Template_Parameter_LongValue); ok {
+				shift := int64(v.LongValue)
+				properties.Shift = &shift
+			}
+		case ParameterBase:
+			properties.Base = doubleParameterValue(parameter)
+		case ParameterAssertion:
+			properties.Assertion = parameter.GetStringValue()
+		case ParameterMediaType:
+			properties.MediaType = parameter.GetStringValue()
+		case ParameterOptional:
+			if err := decodeJSONParameter(parameter, &properties.Optional); err != nil {
+				return dtos.DeviceProfile{}, errors.NewCommonEdgeXWrapper(err)
+			}
+		}
+	}
+
+	// fall back to the member datatype for templates that were not created by ProfileToTemplate
+	for i, m := range template.GetMetrics() {
+		if profile.DeviceResources[i].Properties.ValueType != "" {
+			continue
+		}
+		valueType, err := ValueTypeFromDataType(protobuf.DataType(m.GetDatatype()))
+		if err != nil {
+			return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to convert template member %s", m.GetName()), err)
+		}
+		profile.DeviceResources[i].Properties.ValueType = valueType
+	}
+
+	return profile, nil
+}
+
+// ProfileToTemplateInstance creates the Template instance metric of a device using the profile, with a null
+// member metric per DeviceResource
+func ProfileToTemplateInstance(profile dtos.DeviceProfile, deviceName string) (*protobuf.Payload_Metric, errors.EdgeX) {
+	definition, err := ProfileToTemplate(profile)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	return &protobuf.Payload_Metric{
+		Name:     proto.String(deviceName),
+		Datatype: proto.Uint32(uint32(protobuf.DataType_Template)),
+		Value: &protobuf.Payload_Metric_TemplateValue{TemplateValue: &protobuf.Payload_Template{
+			IsDefinition: proto.Bool(false),
+			TemplateRef:  proto.String(profile.Name),
+			Metrics:      definition.GetTemplateValue().GetMetrics(),
+		}},
+	}, nil
+}
+
+func resourceParameter(resourceName, property string) string {
+	return resourceName + TemplateParameterSeparator + property
+}
+
+func appendStringParameter(parameters []*protobuf.Payload_Template_Parameter, name, value string) []*protobuf.Payload_Template_Parameter {
+	if value == "" {
+		return parameters
+	}
+	return append(parameters, &protobuf.Payload_Template_Parameter{
+		Name:  proto.String(name),
+		Type:  proto.Uint32(uint32(protobuf.DataType_String)),
+		Value: &protobuf.Payload_Template_Parameter_StringValue{StringValue: value},
+	})
+}
+
+func appendDoubleParameter(parameters []*protobuf.Payload_Template_Parameter, name string, value *float64) []*protobuf.Payload_Template_Parameter {
+	if value == nil {
+		return parameters
+	}
+	return append(parameters, &protobuf.Payload_Template_Parameter{
+		Name:  proto.String(name),
+		Type:  proto.Uint32(uint32(protobuf.DataType_Double)),
+		Value: &protobuf.Payload_Template_Parameter_DoubleValue{DoubleValue: *value},
+	})
+}
+
+func appendUInt64Parameter(parameters []*protobuf.Payload_Template_Parameter, name string, value *uint64) []*protobuf.Payload_Template_Parameter {
+	if value == nil {
+		return parameters
+	}
+	return append(parameters, &protobuf.Payload_Template_Parameter{
+		Name:  proto.String(name),
+		Type:  proto.Uint32(uint32(protobuf.DataType_UInt64)),
+		Value: &protobuf.Payload_Template_Parameter_LongValue{LongValue: *value},
+	})
+}
+
+func appendInt64Parameter(parameters []*protobuf.Payload_Template_Parameter, name string, value *int64) []*protobuf.Payload_Template_Parameter {
+	if value == nil {
+		return parameters
+	}
+	return append(parameters, &protobuf.Payload_Template_Parameter{
+		Name:  proto.String(name),
+		Type:  proto.Uint32(uint32(protobuf.DataType_Int64)),
+		Value: &protobuf.Payload_Template_Parameter_LongValue{LongValue: uint64(*value)},
+	})
+}
+
+func appendJSONParameter(parameters []*protobuf.Payload_Template_Parameter, name string, value any) ([]*protobuf.Payload_Template_Parameter, errors.EdgeX) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to encode template parameter %s", name), err)
+	}
+	return appendStringParameter(parameters, name, string(data)), nil
+}
+
+func decodeJSONParameter(parameter *protobuf.Payload_Template_Parameter, value any) errors.EdgeX {
+	if err := json.Unmarshal([]byte(parameter.GetStringValue()), value); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to decode template parameter %s", parameter.GetName()), err)
+	}
+	return nil
+}
+
+func doubleParameterValue(parameter *protobuf.Payload_Template_Parameter) *float64 {
+	var value float64
+	switch v := parameter.GetValue().(type) {
+	case *protobuf.Payload_Template_Parameter_DoubleValue:
+		value = v.DoubleValue
+	case *protobuf.Payload_Template_Parameter_FloatValue:
+		value = float64(v.FloatValue)
+	case *protobuf.Payload_Template_Parameter_IntValue:
+		value = float64(int32(v.IntValue))
+	case *protobuf.Payload_Template_Parameter_LongValue:
+		value = float64(int64(v.LongValue))
+	default:
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,138 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sparkplug
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/sparkplug/protobuf"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestProfileToTemplate_RoundTrip(t *testing.T) {
+	scale := 0.1
+	offset := float64(-273)
+	profile := testProfile()
+	profile.Description = "test profile"
+	profile.Manufacturer = "IOTech"
+	profile.Model = "T1000"
+	profile.ApiVersion = common.ApiVersion
+	profile.Labels = []string{"thermal", "demo"}
+	profile.DeviceResources[0].Description = "ambient temperature"
+	profile.DeviceResources[0].IsHidden = true
+	profile.DeviceResources[0].Tag = "ambient"
+	profile.DeviceResources[0].Attributes = map[string]any{"primaryTable": "HOLDING_REGISTERS", "startingAddress": 40001}
+	profile.DeviceResources[0].Tags = map[string]any{"zone": "north", "floor": 2}
+	profile.DeviceResources[0].Properties.Scale = &scale
+	profile.DeviceResources[0].Properties.Offset = &offset
+	mask := uint64(0xFF00)
+	shift := int64(-8)
+	base := float64(2)
+	profile.DeviceResources = append(profile.DeviceResources,
+		dtos.DeviceResource{Name: "status.code", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeObjectArray, ReadWrite: common.ReadWrite_W}},
+		dtos.DeviceResource{Name: "raw", Properties: dtos.ResourceProperties{
+			ValueType: common.ValueTypeUint16,
+			ReadWrite: common.ReadWrite_R,
+			Mask:      &mask,
+			Shift:     &shift,
+			Base:      &base,
+			Assertion: "0",
+			MediaType: "text/plain",
+			Optional:  map[string]any{"register": 40001, "swap": true},
+		}})
+	profile.DeviceCommands = []dtos.DeviceCommand{{
+		Name:               "status",
+		IsHidden:           true,
+		ReadWrite:          common.ReadWrite_R,
+		ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "raw", Mappings: map[string]string{"0": "off"}}},
+		Tags:               map[string]any{"group": "diagnostics"},
+	}}
+
+	metric, err := ProfileToTemplate(profile)
+	require.NoError(t, err)
+	assert.Equal(t, testProfileName, metric.GetName())
+	assert.Equal(t, uint32(protobuf.DataType_Template), metric.GetDatatype())
+	assert.True(t, metric.GetTemplateValue().GetIsDefinition())
+	require.Len(t, metric.GetTemplateValue().GetMetrics(), 4)
+	assert.Equal(t, uint32(protobuf.DataType_Double), metric.GetTemplateValue().GetMetrics()[0].GetDatatype())
+
+	data, marshalErr := proto.Marshal(metric)
+	require.NoError(t, marshalErr)
+	decoded := &protobuf.Payload_Metric{}
+	require.NoError(t, proto.Unmarshal(data, decoded))
+
+	result, err := TemplateToProfile(decoded)
+	require.NoError(t, err)
+	// the JSON parameters are decoded as the JSON of the profile is, e.g. the numbers come back as float64
+	profileJSON, jsonErr := json.Marshal(profile)
+	require.NoError(t, jsonErr)
+	var expected dtos.DeviceProfile
+	require.NoError(t, json.Unmarshal(profileJSON, &expected))
+	assert.Equal(t, expected, result)
+	assert.Equal(t, float64(40001), result.DeviceResources[3].Properties.Optional["register"])
+}
+
+func TestTemplateToProfile_MemberDatatype(t *testing.T) {
+	metric := &protobuf.Payload_Metric{
+		Name:     proto.String("udt"),
+		Datatype: proto.Uint32(uint32(protobuf.DataType_Template)),
+		Value: &protobuf.Payload_Metric_TemplateValue{TemplateValue: &protobuf.Payload_Template{
+			IsDefinition: proto.Bool(true),
+			Metrics: []*protobuf.Payload_Metric{
+				{Name: proto.String("speed"), Datatype: proto.Uint32(uint32(protobuf.DataType_Int32))},
+			},
+			Parameters: []*protobuf.Payload_Template_Parameter{
+				{Name: proto.String("speed.minimum"), Value: &protobuf.Payload_Template_Parameter_IntValue{IntValue: uint32(0xFFFFFFFF)}},
+				{Name: proto.String("owner"), Value: &protobuf.Payload_Template_Parameter_StringValue{StringValue: "ignition"}},
+			},
+		}},
+	}
+
+	result, err := TemplateToProfile(metric)
+	require.NoError(t, err)
+	require.Len(t, result.DeviceResources, 1)
+	assert.Equal(t, common.ValueTypeInt32, result.DeviceResources[0].Properties.ValueType)
+	require.NotNil(t, result.DeviceResources[0].Properties.Minimum)
+	assert.Equal(t, float64(-1), *result.DeviceResources[0].Properties.Minimum)
+}
+
+func TestTemplateToProfile_Error(t *testing.T) {
+	instance, err := ProfileToTemplateInstance(testProfile(), testDeviceName)
+	require.NoError(t, err)
+	assert.Equal(t, testDeviceName, instance.GetName())
+	assert.Equal(t, testProfileName, instance.GetTemplateValue().GetTemplateRef())
+
+	unknownMember := &protobuf.Payload_Metric{
+		Datatype: proto.Uint32(uint32(protobuf.DataType_Template)),
+		Value: &protobuf.Payload_Metric_TemplateValue{TemplateValue: &protobuf.Payload_Template{
+			IsDefinition: proto.Bool(true),
+			Parameters: []*protobuf.Payload_Template_Parameter{
+				{Name: proto.String("missing.units"), Value: &protobuf.Payload_Template_Parameter_StringValue{StringValue: "degC"}},
+			},
+		}},
+	}
+
+	tests := []struct {
+		name   string
+		metric *protobuf.Payload_Metric
+	}{
+		{"not a template", &protobuf.Payload_Metric{Datatype: proto.Uint32(uint32(protobuf.DataType_Int32))}},
+		{"template instance", instance},
+		{"parameter of unknown member", unknownMember},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := TemplateToProfile(testCase.metric)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,135 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sparkplug
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// Namespace is the first element of every Sparkplug B topic
+const Namespace = "spBv1.0"
+
+// MessageType is the Sparkplug B message type element of a topic
+type MessageType string
+
+const (
+	MessageTypeNBirth MessageType = "NBIRTH"
+	MessageTypeNData  MessageType = "NDATA"
+	MessageTypeNCmd   MessageType = "NCMD"
+	MessageTypeNDeath MessageType = "NDEATH"
+	MessageTypeDBirth MessageType = "DBIRTH"
+	MessageTypeDData  MessageType = "DDATA"
+	MessageTypeDCmd   MessageType = "DCMD"
+	MessageTypeDDeath MessageType = "DDEATH"
+)
+
+// IsDeviceMessage reports whether the message type is published on behalf of a device rather than the edge node
+func (m MessageType) IsDeviceMessage() bool {
+	switch m {
+	case MessageTypeDBirth, MessageTypeDData, MessageTypeDCmd, MessageTypeDDeath:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether the message type is one of the Sparkplug B message types
+func (m MessageType) IsValid() bool {
+	switch m {
+	case MessageTypeNBirth, MessageTypeNData, MessageTypeNCmd, MessageTypeNDeath,
+		MessageTypeDBirth, MessageTypeDData, MessageTypeDCmd, MessageTypeDDeath:
+		return true
+	}
+	return false
+}
+
+// Topic is the parsed form of spBv1.0/{group_id}/{message_type}/{edge_node_id}[/{device_id}]
+type Topic struct {
+	GroupId     string
+	MessageType MessageType
+	EdgeNodeId  string
+	DeviceId    string
+}
+
+// NewNodeTopic creates a Topic for an edge node level message type
+func NewNodeTopic(groupId string, messageType MessageType, edgeNodeId string) (Topic, errors.EdgeX) {
+	topic := Topic{GroupId: groupId, MessageType: messageType, EdgeNodeId: edgeNodeId}
+	if err := topic.Validate(); err != nil {
+		return Topic{}, err
+	}
+	return topic, nil
+}
+
+// NewDeviceTopic creates a Topic for a device level message type
+func NewDeviceTopic(groupId string, messageType MessageType, edgeNodeId, deviceId string) (Topic, errors.EdgeX) {
+	topic := Topic{GroupId: groupId, MessageType: messageType, EdgeNodeId: edgeNodeId, DeviceId: deviceId}
+	if err := topic.Validate(); err != nil {
+		return Topic{}, err
+	}
+	return topic, nil
+}
+
+// ParseTopic parses and validates a Sparkplug B topic string
+func ParseTopic(topic string) (Topic, errors.EdgeX) {
+	elements := strings.Split(topic, "/")
+	if len(elements) != 4 && len(elements) != 5 {
+		return Topic{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("topic '%s' does not have 4 or 5 elements", topic), nil)
+	}
+	if elements[0] != Namespace {
+		return Topic{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("topic '%s' is not in the %s namespace", topic, Namespace), nil)
+	}
+
+	result := Topic{
+		GroupId:     elements[1],
+		MessageType: MessageType(elements[2]),
+		EdgeNodeId:  elements[3],
+	}
+	if len(elements) == 5 {
+		result.DeviceId = elements[4]
+		if result.DeviceId == "" {
+			return Topic{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("topic '%s' has an empty device id", topic), nil)
+		}
+	}
+	if err := result.Validate(); err != nil {
+		return Topic{}, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("invalid topic '%s'", topic), err)
+	}
+	return result, nil
+}
+
+// Validate checks the topic elements are present, valid and consistent with the message type
+func (t Topic) Validate() errors.EdgeX {
+	if !t.MessageType.IsValid() {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown message type '%s'", t.MessageType), nil)
+	}
+	for name, id := range map[string]string{"group id": t.GroupId, "edge node id": t.EdgeNodeId, "device id": t.DeviceId} {
+		if strings.ContainsAny(id, "/+#") {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s '%s' contains a reserved character", name, id), nil)
+		}
+	}
+	if t.GroupId == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "group id is empty", nil)
+	}
+	if t.EdgeNodeId == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "edge node id is empty", nil)
+	}
+	if t.MessageType.IsDeviceMessage() && t.DeviceId == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s requires a device id", t.MessageType), nil)
+	}
+	if !t.MessageType.IsDeviceMessage() && t.DeviceId != "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s must not have a device id", t.MessageType), nil)
+	}
+	return nil
+}
+
+// String formats the topic, omitting the device id for edge node level message types
+func (t Topic) String() string {
+	if t.DeviceId == "" {
+		return strings.Join([]string{Namespace, t.GroupId, string(t.MessageType), t.EdgeNodeId}, "/")
+	}
+	return strings.Join([]string{Namespace, t.GroupId, string(t.MessageType), t.EdgeNodeId, t.DeviceId}, "/")
+}
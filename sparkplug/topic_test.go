@@ -0,0 +1,70 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sparkplug
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTopic(t *testing.T) {
+	tests := []struct {
+		name          string
+		topic         string
+		expected      Topic
+		expectedError bool
+	}{
+		{"NBIRTH", "spBv1.0/group/NBIRTH/edge", Topic{GroupId: "group", MessageType: MessageTypeNBirth, EdgeNodeId: "edge"}, false},
+		{"NDATA", "spBv1.0/group/NDATA/edge", Topic{GroupId: "group", MessageType: MessageTypeNData, EdgeNodeId: "edge"}, false},
+		{"NCMD", "spBv1.0/group/NCMD/edge", Topic{GroupId: "group", MessageType: MessageTypeNCmd, EdgeNodeId: "edge"}, false},
+		{"NDEATH", "spBv1.0/group/NDEATH/edge", Topic{GroupId: "group", MessageType: MessageTypeNDeath, EdgeNodeId: "edge"}, false},
+		{"DBIRTH", "spBv1.0/group/DBIRTH/edge/device", Topic{GroupId: "group", MessageType: MessageTypeDBirth, EdgeNodeId: "edge", DeviceId: "device"}, false},
+		{"DDATA", "spBv1.0/group/DDATA/edge/device", Topic{GroupId: "group", MessageType: MessageTypeDData, EdgeNodeId: "edge", DeviceId: "device"}, false},
+		{"DCMD", "spBv1.0/group/DCMD/edge/device", Topic{GroupId: "group", MessageType: MessageTypeDCmd, EdgeNodeId: "edge", DeviceId: "device"}, false},
+		{"DDEATH", "spBv1.0/group/DDEATH/edge/device", Topic{GroupId: "group", MessageType: MessageTypeDDeath, EdgeNodeId: "edge", DeviceId: "device"}, false},
+		{"wrong namespace", "spAv1.0/group/NBIRTH/edge", Topic{}, true},
+		{"unknown message type", "spBv1.0/group/NFOO/edge", Topic{}, true},
+		{"too few elements", "spBv1.0/group/NBIRTH", Topic{}, true},
+		{"too many elements", "spBv1.0/group/DDATA/edge/device/extra", Topic{}, true},
+		{"empty group", "spBv1.0//NBIRTH/edge", Topic{}, true},
+		{"empty edge node", "spBv1.0/group/NBIRTH/", Topic{}, true},
+		{"empty device", "spBv1.0/group/DBIRTH/edge/", Topic{}, true},
+		{"node message with device", "spBv1.0/group/NDATA/edge/device", Topic{}, true},
+		{"device message without device", "spBv1.0/group/DDATA/edge", Topic{}, true},
+		{"wildcard", "spBv1.0/+/NDATA/edge", Topic{}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := ParseTopic(testCase.topic)
+			if testCase.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, result)
+			assert.Equal(t, testCase.topic, result.String())
+		})
+	}
+}
+
+func TestNewTopic(t *testing.T) {
+	topic, err := NewNodeTopic("group", MessageTypeNBirth, "edge")
+	require.NoError(t, err)
+	assert.Equal(t, "spBv1.0/group/NBIRTH/edge", topic.String())
+
+	topic, err = NewDeviceTopic("group", MessageTypeDDeath, "edge", "device")
+	require.NoError(t, err)
+	assert.Equal(t, "spBv1.0/group/DDEATH/edge/device", topic.String())
+
+	_, err = NewNodeTopic("group", MessageTypeDBirth, "edge")
+	assert.Error(t, err)
+	_, err = NewDeviceTopic("group", MessageTypeNBirth, "edge", "device")
+	assert.Error(t, err)
+	_, err = NewDeviceTopic("group", MessageTypeDData, "edge", "dev/ice")
+	assert.Error(t, err)
+}
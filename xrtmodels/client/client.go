@@ -0,0 +1,235 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/xrtmodels"
+)
+
+// Client sends XRT management requests over the message bus and waits for the matching reply.
+// Replies are correlated to requests by the request_id, so one Client can have many requests in flight.
+type Client struct {
+	clientName   string
+	requestTopic string
+	timeout      time.Duration
+	publisher    Publisher
+
+	mutex   sync.Mutex
+	pending map[string]chan []byte
+}
+
+// NewClient creates a Client which publishes requests to requestTopic and subscribes to replyTopic for the replies.
+// The timeout applies to every request whose context has no deadline.
+func NewClient(clientName, requestTopic, replyTopic string, timeout time.Duration, publisher Publisher, subscriber Subscriber) (*Client, errors.EdgeX) {
+	c := &Client{
+		clientName:   clientName,
+		requestTopic: requestTopic,
+		timeout:      timeout,
+		publisher:    publisher,
+		pending:      make(map[string]chan []byte),
+	}
+	if err := subscriber.Subscribe(replyTopic, c.handleReply); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindCommunicationError, fmt.Sprintf("failed to subscribe to the reply topic %s", replyTopic), err)
+	}
+	return c, nil
+}
+
+func (c *Client) handleReply(payload []byte) {
+	var response xrtmodels.BaseResponse
+	if err := json.Unmarshal(payload, &response); err != nil {
+		// the reply topic may be shared with other clients, so ignore anything that is not a reply
+		return
+	}
+
+	c.mutex.Lock()
+	replyChan, ok := c.pending[response.RequestId]
+	delete(c.pending, response.RequestId)
+	c.mutex.Unlock()
+	if ok {
+		replyChan <- payload
+	}
+}
+
+// sendRequest publishes the request and decodes the reply with the same request id into the response
+func (c *Client) sendRequest(ctx context.Context, requestId string, request any, response any) errors.EdgeX {
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode the XRT request", err)
+	}
+
+	replyChan := make(chan []byte, 1)
+	c.mutex.Lock()
+	c.pending[requestId] = replyChan
+	c.mutex.Unlock()
+	defer func() {
+		c.mutex.Lock()
+		delete(c.pending, requestId)
+		c.mutex.Unlock()
+	}()
+
+	if _, ok := ctx.Deadline(); !ok && c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	if err = c.publisher.Publish(c.requestTopic, payload); err != nil {
+		return errors.NewCommonEdgeX(errors.KindCommunicationError, fmt.Sprintf("failed to publish the XRT request %s", requestId), err)
+	}
+
+	select {
+	case reply := <-replyChan:
+		if err = json.Unmarshal(reply, response); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to decode the reply of XRT request %s", requestId), err)
+		}
+		return nil
+	case <-ctx.Done():
+		return errors.NewCommonEdgeX(errors.KindServiceUnavailable, fmt.Sprintf("no reply to XRT request %s", requestId), ctx.Err())
+	}
+}
+
+// sendCommonRequest sends a request whose reply only carries the status
+func (c *Client) sendCommonRequest(ctx context.Context, requestId string, request any) errors.EdgeX {
+	var response xrtmodels.CommonResponse
+	if err := c.sendRequest(ctx, requestId, request, &response); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return response.Result.Error()
+}
+
+// AddProfile adds the device profile to XRT
+func (c *Client) AddProfile(ctx context.Context, profile dtos.DeviceProfile) errors.EdgeX {
+	req := xrtmodels.NewProfileAddRequest(profile, c.clientName)
+	return c.sendCommonRequest(ctx, req.RequestId, req)
+}
+
+// UpdateProfile updates the device profile in XRT
+func (c *Client) UpdateProfile(ctx context.Context, profile dtos.DeviceProfile) errors.EdgeX {
+	req := xrtmodels.NewProfileUpdateRequest(profile, c.clientName)
+	return c.sendCommonRequest(ctx, req.RequestId, req)
+}
+
+// DeleteProfile deletes the device profile from XRT
+func (c *Client) DeleteProfile(ctx context.Context, profileName string) errors.EdgeX {
+	req := xrtmodels.NewProfileDeleteRequest(profileName, c.clientName)
+	return c.sendCommonRequest(ctx, req.RequestId, req)
+}
+
+// GetProfile reads the device profile from XRT
+func (c *Client) GetProfile(ctx context.Context, profileName string) (res xrtmodels.ProfileResponse, err errors.EdgeX) {
+	req := xrtmodels.NewProfileGetRequest(profileName, c.clientName)
+	if err = c.sendRequest(ctx, req.RequestId, req, &res); err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, res.Result.Error()
+}
+
+// AllProfiles lists the device profile names known to XRT
+func (c *Client) AllProfiles(ctx context.Context) (res xrtmodels.MultiProfilesResponse, err errors.EdgeX) {
+	req := xrtmodels.NewAllProfilesRequest(c.clientName)
+	if err = c.sendRequest(ctx, req.RequestId, req, &res); err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, res.Result.Error()
+}
+
+// AddDevice adds the device to XRT
+func (c *Client) AddDevice(ctx context.Context, device xrtmodels.DeviceInfo) errors.EdgeX {
+	req := xrtmodels.NewDeviceAddRequest(device, c.clientName)
+	return c.sendCommonRequest(ctx, req.RequestId, req)
+}
+
+// UpdateDevice updates the device in XRT
+func (c *Client) UpdateDevice(ctx context.Context, device xrtmodels.DeviceInfo) errors.EdgeX {
+	req := xrtmodels.NewDeviceUpdateRequest(device, c.clientName)
+	return c.sendCommonRequest(ctx, req.RequestId, req)
+}
+
+// DeleteDevice deletes the device from XRT
+func (c *Client) DeleteDevice(ctx context.Context, deviceName string) errors.EdgeX {
+	req := xrtmodels.NewDeviceDeleteRequest(deviceName, c.clientName)
+	return c.sendCommonRequest(ctx, req.RequestId, req)
+}
+
+// GetDevice reads the device from XRT
+func (c *Client) GetDevice(ctx context.Context, deviceName string) (res xrtmodels.DeviceResponse, err errors.EdgeX) {
+	req := xrtmodels.NewDeviceGetRequest(deviceName, c.clientName)
+	if err = c.sendRequest(ctx, req.RequestId, req, &res); err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, res.Result.Error()
+}
+
+// AllDevices lists the device names known to XRT
+func (c *Client) AllDevices(ctx context.Context) (res xrtmodels.MultiDevicesResponse, err errors.EdgeX) {
+	req := xrtmodels.NewAllDevicesRequest(c.clientName)
+	if err = c.sendRequest(ctx, req.RequestId, req, &res); err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, res.Result.Error()
+}
+
+// GetResources reads the resources of the device
+func (c *Client) GetResources(ctx context.Context, deviceName string, resources []string) (res xrtmodels.MultiResourcesResponse, err errors.EdgeX) {
+	req := xrtmodels.NewDeviceResourceGetRequest(deviceName, c.clientName, resources)
+	if err = c.sendRequest(ctx, req.RequestId, req, &res); err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, res.Result.Error()
+}
+
+// PutResources writes the resource values to the device
+func (c *Client) PutResources(ctx context.Context, deviceName string, values map[string]any, options map[string]any) errors.EdgeX {
+	req := xrtmodels.NewDeviceResourceSetRequest(deviceName, c.clientName, values, options)
+	return c.sendCommonRequest(ctx, req.RequestId, req)
+}
+
+// AddSchedule adds the schedule to XRT
+func (c *Client) AddSchedule(ctx context.Context, schedule xrtmodels.Schedule) errors.EdgeX {
+	req := xrtmodels.NewScheduleAddRequest(c.clientName, schedule)
+	return c.sendCommonRequest(ctx, req.RequestId, req)
+}
+
+// DeleteSchedule deletes the schedule from XRT
+func (c *Client) DeleteSchedule(ctx context.Context, scheduleName string) errors.EdgeX {
+	req := xrtmodels.NewScheduleDeleteRequest(scheduleName, c.clientName)
+	return c.sendCommonRequest(ctx, req.RequestId, req)
+}
+
+// AllSchedules lists the schedule names known to XRT
+func (c *Client) AllSchedules(ctx context.Context) (res xrtmodels.MultiSchedulesResponse, err errors.EdgeX) {
+	req := xrtmodels.NewAllSchedulesRequest(c.clientName)
+	if err = c.sendRequest(ctx, req.RequestId, req, &res); err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, res.Result.Error()
+}
+
+// TriggerDiscovery starts a device discovery, the discovered devices are published asynchronously
+func (c *Client) TriggerDiscovery(ctx context.Context, options map[string]any) errors.EdgeX {
+	req := xrtmodels.NewDiscoveryRequest(c.clientName, options)
+	return c.sendCommonRequest(ctx, req.RequestId, req)
+}
+
+// UpdateComponent updates the configuration of the XRT component
+func (c *Client) UpdateComponent(ctx context.Context, component string, config map[string]any) errors.EdgeX {
+	req := xrtmodels.NewComponentUpdateRequest(component, c.clientName, config)
+	return c.sendCommonRequest(ctx, req.RequestId, req)
+}
+
+// DiscoverComponents reads the state and configuration of the XRT components, optionally filtered by category
+func (c *Client) DiscoverComponents(ctx context.Context, category string) (res xrtmodels.MultiComponentsResponse, err errors.EdgeX) {
+	req := xrtmodels.NewComponentDiscoverRequest(c.clientName, category)
+	if err = c.sendRequest(ctx, req.RequestId, req, &res); err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
@@ -0,0 +1,166 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/xrtmodels"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testClientName   = "test-client"
+	testRequestTopic = "xrt/request"
+	testReplyTopic   = "xrt/reply"
+	testDeviceName   = "test-device"
+)
+
+// startXrt subscribes a fake XRT instance to the request topic which replies with the result of the handler. The
+// handler runs on the goroutine of the transport, so it must not call t.FailNow, i.e. it uses assert rather than require.
+func startXrt(t *testing.T, transport *InMemoryTransport, handler func(payload []byte) any) {
+	err := transport.Subscribe(testRequestTopic, func(payload []byte) {
+		reply := handler(payload)
+		if reply == nil {
+			return
+		}
+		data, err := json.Marshal(reply)
+		assert.NoError(t, err)
+		assert.NoError(t, transport.Publish(testReplyTopic, data))
+	})
+	require.NoError(t, err)
+}
+
+func baseResponse(t *testing.T, payload []byte) xrtmodels.BaseResponse {
+	var request xrtmodels.BaseRequest
+	assert.NoError(t, json.Unmarshal(payload, &request))
+	return xrtmodels.BaseResponse{Client: request.Client, RequestId: request.RequestId, Type: xrtmodels.MessageTypeReply}
+}
+
+// pendingCount returns the number of requests awaiting their replies
+func pendingCount(c *Client) int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.pending)
+}
+
+func newTestClient(t *testing.T, transport *InMemoryTransport, timeout time.Duration) *Client {
+	c, err := NewClient(testClientName, testRequestTopic, testReplyTopic, timeout, transport, transport)
+	require.NoError(t, err)
+	return c
+}
+
+func TestClient_GetResources(t *testing.T) {
+	transport := NewInMemoryTransport()
+	startXrt(t, transport, func(payload []byte) any {
+		var request xrtmodels.GetResourcesRequest
+		assert.NoError(t, json.Unmarshal(payload, &request))
+		assert.Equal(t, xrtmodels.DeviceResourceGetOperation, request.Op)
+		return xrtmodels.MultiResourcesResponse{
+			BaseResponse: baseResponse(t, payload),
+			Result: xrtmodels.MultiResourcesResult{
+				Device: request.DeviceName,
+				Readings: map[string]xrtmodels.Reading{
+					request.Resource[0]: {Value: 12.5, Type: common.ValueTypeFloat64},
+				},
+			},
+		}
+	})
+	c := newTestClient(t, transport, time.Second)
+
+	res, err := c.GetResources(context.Background(), testDeviceName, []string{"temperature"})
+	require.NoError(t, err)
+	assert.Equal(t, testDeviceName, res.Result.Device)
+	assert.Equal(t, 12.5, res.Result.Readings["temperature"].Value)
+}
+
+func TestClient_ResultError(t *testing.T) {
+	transport := NewInMemoryTransport()
+	startXrt(t, transport, func(payload []byte) any {
+		return xrtmodels.CommonResponse{
+			BaseResponse: baseResponse(t, payload),
+			Result:       xrtmodels.BaseResult{Status: xrtmodels.XrtSdkStatusAlreadyExists, ErrorMessage: "device already exists"},
+		}
+	})
+	c := newTestClient(t, transport, time.Second)
+
+	err := c.AddDevice(context.Background(), xrtmodels.DeviceInfo{Device: dtos.Device{Name: testDeviceName}})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindDuplicateName, errors.Kind(err))
+	assert.Contains(t, err.Error(), "device already exists")
+}
+
+func TestClient_ConcurrentRequests(t *testing.T) {
+	transport := NewInMemoryTransport()
+	startXrt(t, transport, func(payload []byte) any {
+		var request xrtmodels.DeviceRequest
+		assert.NoError(t, json.Unmarshal(payload, &request))
+		return xrtmodels.DeviceResponse{
+			BaseResponse: baseResponse(t, payload),
+			Result:       xrtmodels.DeviceResult{Device: xrtmodels.DeviceInfo{Device: dtos.Device{Name: request.Device}}},
+		}
+	})
+	c := newTestClient(t, transport, time.Second)
+
+	deviceNames := []string{"device-1", "device-2", "device-3", "device-4", "device-5"}
+	var wg sync.WaitGroup
+	for _, deviceName := range deviceNames {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := c.GetDevice(context.Background(), deviceName)
+			assert.NoError(t, err)
+			assert.Equal(t, deviceName, res.Result.Device.Name)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestClient_Timeout(t *testing.T) {
+	transport := NewInMemoryTransport()
+	startXrt(t, transport, func(payload []byte) any {
+		return nil
+	})
+	c := newTestClient(t, transport, 50*time.Millisecond)
+
+	err := c.DeleteDevice(context.Background(), testDeviceName)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = c.TriggerDiscovery(ctx, nil)
+	require.Error(t, err)
+	assert.Zero(t, pendingCount(c))
+}
+
+func TestClient_IgnoreUnknownReplies(t *testing.T) {
+	transport := NewInMemoryTransport()
+	startXrt(t, transport, func(payload []byte) any {
+		// a reply to another request and a non-JSON message arrive before the expected reply
+		assert.NoError(t, transport.Publish(testReplyTopic, []byte("not json")))
+		other := xrtmodels.CommonResponse{BaseResponse: xrtmodels.BaseResponse{RequestId: "other"}, Result: xrtmodels.BaseResult{Status: xrtmodels.XrtSdkStatusServerError}}
+		data, err := json.Marshal(other)
+		assert.NoError(t, err)
+		assert.NoError(t, transport.Publish(testReplyTopic, data))
+		return xrtmodels.MultiComponentsResponse{
+			BaseResponse: baseResponse(t, payload),
+			Result:       xrtmodels.ComponentsDiscoveryResponse{Components: []xrtmodels.Component{{Name: "opcua-server"}}},
+		}
+	})
+	c := newTestClient(t, transport, time.Second)
+
+	res, err := c.DiscoverComponents(context.Background(), "")
+	require.NoError(t, err)
+	require.Len(t, res.Result.Components, 1)
+	assert.Equal(t, "opcua-server", res.Result.Components[0].Name)
+}
@@ -0,0 +1,13 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package client
+
+// Publisher sends a message to a topic of the message bus the XRT instance is connected to
+type Publisher interface {
+	Publish(topic string, payload []byte) error
+}
+
+// Subscriber delivers every message received on the topic to the handler
+type Subscriber interface {
+	Subscribe(topic string, handler func(payload []byte)) error
+}
@@ -0,0 +1,39 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package client
+
+import (
+	"sync"
+)
+
+// InMemoryTransport is a Publisher and Subscriber which delivers messages to the handlers subscribed to the exact
+// topic, each on its own goroutine. It is meant for tests which stand in for the XRT instance.
+type InMemoryTransport struct {
+	mutex    sync.RWMutex
+	handlers map[string][]func(payload []byte)
+}
+
+// NewInMemoryTransport creates an InMemoryTransport without subscriptions
+func NewInMemoryTransport() *InMemoryTransport {
+	return &InMemoryTransport{handlers: make(map[string][]func(payload []byte))}
+}
+
+// Publish delivers a copy of the payload to every handler subscribed to the topic
+func (t *InMemoryTransport) Publish(topic string, payload []byte) error {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	for _, handler := range t.handlers[topic] {
+		data := make([]byte, len(payload))
+		copy(data, payload)
+		go handler(data)
+	}
+	return nil
+}
+
+// Subscribe registers the handler for the topic
+func (t *InMemoryTransport) Subscribe(topic string, handler func(payload []byte)) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.handlers[topic] = append(t.handlers[topic], handler)
+	return nil
+}
@@ -25,6 +25,21 @@ const (
 
 	DeviceServiceCategory      = "XRT::DeviceService"
 	DeviceServiceRunningStatus = "Running"
+
+	OPCUAServerCategory = "XRT::OPCUAServer"
+	MQTTBusCategory     = "XRT::MQTTBus"
+)
+
+// constants of MQTT bus settings
+const (
+	MQTTBusUrl          = "Url"
+	MQTTBusClientId     = "ClientId"
+	MQTTBusUsername     = "Username"
+	MQTTBusPassword     = "Password"
+	MQTTBusKeepAlive    = "KeepAlive"
+	MQTTBusQoS          = "QoS"
+	MQTTBusRetained     = "Retained"
+	MQTTBusCleanSession = "CleanSession"
 )
 
 // constants of env to override the device connector settings
@@ -39,6 +54,15 @@ const (
 	EnvXRTOPCUAServerUseMiddlewarePrefixEvent      = "XRT_OPCUA_SERVER_USE_MIDDLEWARE_PREFIX_EVENT"
 	EnvXRTOPCUAServerUseMiddlewarePrefixEdgeXEvent = "XRT_OPCUA_SERVER_USE_MIDDLEWARE_PREFIX_EDGEX_EVENT"
 	EnvXRTOPCUAServerEdgeXEventTopicBase           = "XRT_OPCUA_SERVER_EDGEX_EVENT_TOPIC_BASE"
+
+	EnvXRTMQTTBusUrl          = "XRT_MQTT_BUS_URL"
+	EnvXRTMQTTBusClientId     = "XRT_MQTT_BUS_CLIENT_ID"
+	EnvXRTMQTTBusUsername     = "XRT_MQTT_BUS_USERNAME"
+	EnvXRTMQTTBusPassword     = "XRT_MQTT_BUS_PASSWORD" // nolint:gosec
+	EnvXRTMQTTBusKeepAlive    = "XRT_MQTT_BUS_KEEP_ALIVE"
+	EnvXRTMQTTBusQoS          = "XRT_MQTT_BUS_QOS"
+	EnvXRTMQTTBusRetained     = "XRT_MQTT_BUS_RETAINED"
+	EnvXRTMQTTBusCleanSession = "XRT_MQTT_BUS_CLEAN_SESSION"
 )
 
 type Component struct {
@@ -0,0 +1,253 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package xrtmodels
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// configEnvNames are the XRT_* environment variables which override the config fields, by config key
+var configEnvNames = map[string]string{
+	OPCUAServerRequestTimeout:                EnvXRTOPCUAServerRequestTimeout,
+	OPCUAServerUseTelemetryValues:            EnvXRTOPCUAServerUseTelemetryValues,
+	OPCUAServerStaleTelemetryValueTime:       EnvXRTOPCUAServerStaleTelemetryValueTime,
+	OPCUAServerTopicMiddlewarePrefix:         EnvXRTOPCUAServerTopicMiddlewarePrefix,
+	OPCUAServerUseMiddlewarePrefixRequest:    EnvXRTOPCUAServerUseMiddlewarePrefixRequest,
+	OPCUAServerUseMiddlewarePrefixReply:      EnvXRTOPCUAServerUseMiddlewarePrefixReply,
+	OPCUAServerUseMiddlewarePrefixTelemetry:  EnvXRTOPCUAServerUseMiddlewarePrefixTelemetry,
+	OPCUAServerUseMiddlewarePrefixEvent:      EnvXRTOPCUAServerUseMiddlewarePrefixEvent,
+	OPCUAServerUseMiddlewarePrefixEdgeXEvent: EnvXRTOPCUAServerUseMiddlewarePrefixEdgeXEvent,
+	OPCUAServerEdgeXEventTopicBase:           EnvXRTOPCUAServerEdgeXEventTopicBase,
+	MQTTBusUrl:                               EnvXRTMQTTBusUrl,
+	MQTTBusClientId:                          EnvXRTMQTTBusClientId,
+	MQTTBusUsername:                          EnvXRTMQTTBusUsername,
+	MQTTBusPassword:                          EnvXRTMQTTBusPassword,
+	MQTTBusKeepAlive:                         EnvXRTMQTTBusKeepAlive,
+	MQTTBusQoS:                               EnvXRTMQTTBusQoS,
+	MQTTBusRetained:                          EnvXRTMQTTBusRetained,
+	MQTTBusCleanSession:                      EnvXRTMQTTBusCleanSession,
+}
+
+// extraField is the field of the config structs which keeps the config keys they do not declare
+const extraField = "Extra"
+
+// ComponentConfig is the typed form of Component.Config for a component category. The unset fields, i.e. the empty
+// strings and the nil booleans and numbers, are omitted from the config map, so that they leave the values of the
+// component as they are when the config is updated, while a false or zero which is set is sent.
+type ComponentConfig interface {
+	Category() string
+}
+
+// DeviceConnectorConfig holds the settings shared by the components connected to a device service
+type DeviceConnectorConfig struct {
+	Name           string `json:"Name,omitempty" validate:"required"`
+	EventTopic     string `json:"EventTopic,omitempty"`
+	ReplyTopic     string `json:"ReplyTopic,omitempty" validate:"required"`
+	RequestTopic   string `json:"RequestTopic,omitempty" validate:"required"`
+	TelemetryTopic string `json:"TelemetryTopic,omitempty"`
+	// Extra keeps the keys of the component config which are not declared above, e.g. those of a newer XRT
+	Extra map[string]any `json:"-"`
+}
+
+// DeviceServiceConfig is the config of an XRT::DeviceService component
+type DeviceServiceConfig struct {
+	DeviceConnectorConfig `json:",inline"`
+}
+
+func (DeviceServiceConfig) Category() string {
+	return DeviceServiceCategory
+}
+
+// OPCUAServerConfig is the config of an XRT::OPCUAServer component, the times are in milliseconds
+type OPCUAServerConfig struct {
+	DeviceConnectorConfig                    `json:",inline"`
+	OPCUAServerRequestTimeout                *uint  `json:"OPCUAServerRequestTimeout,omitempty"`
+	OPCUAServerUseTelemetryValues            *bool  `json:"OPCUAServerUseTelemetryValues,omitempty"`
+	OPCUAServerStaleTelemetryValueTime       *uint  `json:"OPCUAServerStaleTelemetryValueTime,omitempty"`
+	OPCUAServerTopicMiddlewarePrefix         string `json:"OPCUAServerTopicMiddlewarePrefix,omitempty"`
+	OPCUAServerUseMiddlewarePrefixRequest    *bool  `json:"OPCUAServerUseMiddlewarePrefixRequest,omitempty"`
+	OPCUAServerUseMiddlewarePrefixReply      *bool  `json:"OPCUAServerUseMiddlewarePrefixReply,omitempty"`
+	OPCUAServerUseMiddlewarePrefixTelemetry  *bool  `json:"OPCUAServerUseMiddlewarePrefixTelemetry,omitempty"`
+	OPCUAServerUseMiddlewarePrefixEvent      *bool  `json:"OPCUAServerUseMiddlewarePrefixEvent,omitempty"`
+	OPCUAServerUseMiddlewarePrefixEdgeXEvent *bool  `json:"OPCUAServerUseMiddlewarePrefixEdgeXEvent,omitempty"`
+	OPCUAServerEdgeXEventTopicBase           string `json:"OPCUAServerEdgeXEventTopicBase,omitempty"`
+}
+
+func (OPCUAServerConfig) Category() string {
+	return OPCUAServerCategory
+}
+
+// MQTTBusConfig is the config of an XRT::MQTTBus component, the KeepAlive is in seconds
+type MQTTBusConfig struct {
+	Url          string `json:"Url,omitempty" validate:"required,uri"`
+	ClientId     string `json:"ClientId,omitempty"`
+	Username     string `json:"Username,omitempty"`
+	Password     string `json:"Password,omitempty"`
+	KeepAlive    *uint  `json:"KeepAlive,omitempty"`
+	QoS          *uint8 `json:"QoS,omitempty" validate:"omitempty,max=2"`
+	Retained     *bool  `json:"Retained,omitempty"`
+	CleanSession *bool  `json:"CleanSession,omitempty"`
+	// Extra keeps the keys of the component config which are not declared above, e.g. those of a newer XRT
+	Extra map[string]any `json:"-"`
+}
+
+func (MQTTBusConfig) Category() string {
+	return MQTTBusCategory
+}
+
+// DecodeComponentConfig decodes the component config into the typed config, which must be a pointer to the config of
+// the component category. The XRT_* environment variables override the decoded values and the result is validated.
+// The keys which are not declared by the config, matched case-sensitively, are kept in its Extra field.
+func DecodeComponentConfig(component Component, config ComponentConfig) errors.EdgeX {
+	value := reflect.ValueOf(config)
+	if value.Kind() != reflect.Pointer || value.IsNil() {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("config of component %s must be decoded into a non-nil pointer", component.Name), nil)
+	}
+	if component.Category != config.Category() {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("component %s of category %s cannot be decoded as %s config", component.Name, component.Category, config.Category()), nil)
+	}
+
+	keys := configKeys(value.Elem().Type())
+	declared := make(map[string]any, len(component.Config))
+	var extra map[string]any
+	for key, v := range component.Config {
+		if _, ok := keys[key]; ok {
+			declared[key] = v
+			continue
+		}
+		if extra == nil {
+			extra = make(map[string]any)
+		}
+		extra[key] = v
+	}
+
+	data, err := json.Marshal(declared)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to encode the config of component %s", component.Name), err)
+	}
+	if err = json.Unmarshal(data, config); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to decode the config of component %s", component.Name), err)
+	}
+	if field := value.Elem().FieldByName(extraField); field.IsValid() {
+		field.Set(reflect.ValueOf(extra))
+	}
+	if edgexErr := applyEnvOverrides(value.Elem()); edgexErr != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to override the config of component %s", component.Name), edgexErr)
+	}
+	if err = common.Validate(config); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid config of component %s", component.Name), err)
+	}
+	return nil
+}
+
+// ComponentConfigToMap converts the typed config to the map carried by Component.Config, along with its Extra keys
+func ComponentConfigToMap(config ComponentConfig) (map[string]any, errors.EdgeX) {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to encode the %s config", config.Category()), err)
+	}
+	var result map[string]any
+	if err = json.Unmarshal(data, &result); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to decode the %s config", config.Category()), err)
+	}
+	if field := reflect.Indirect(reflect.ValueOf(config)).FieldByName(extraField); field.IsValid() {
+		for key, value := range field.Interface().(map[string]any) {
+			if _, ok := result[key]; !ok {
+				result[key] = value
+			}
+		}
+	}
+	return result, nil
+}
+
+// NewComponentConfigUpdateRequest validates the typed config and creates the request to update the component with it
+func NewComponentConfigUpdateRequest(component string, clientName string, config ComponentConfig) (UpdateComponentRequest, errors.EdgeX) {
+	if err := common.Validate(config); err != nil {
+		return UpdateComponentRequest{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid config of component %s", component), err)
+	}
+	configMap, err := ComponentConfigToMap(config)
+	if err != nil {
+		return UpdateComponentRequest{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return NewComponentUpdateRequest(component, clientName, configMap), nil
+}
+
+// configKeys returns the exact JSON keys of the config struct, json.Unmarshal alone would match the keys case-insensitively
+func configKeys(configType reflect.Type) map[string]struct{} {
+	keys := make(map[string]struct{})
+	for i := 0; i < configType.NumField(); i++ {
+		field := configType.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			for key := range configKeys(field.Type) {
+				keys[key] = struct{}{}
+			}
+			continue
+		}
+		if key := strings.Split(field.Tag.Get("json"), ",")[0]; key != "-" {
+			keys[key] = struct{}{}
+		}
+	}
+	return keys
+}
+
+// applyEnvOverrides sets the struct fields of configEnvNames from the environment variables which are set
+func applyEnvOverrides(value reflect.Value) errors.EdgeX {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+		structField := value.Type().Field(i)
+		if structField.Anonymous && field.Kind() == reflect.Struct {
+			if err := applyEnvOverrides(field); err != nil {
+				return err
+			}
+			continue
+		}
+		envName, ok := configEnvNames[strings.Split(structField.Tag.Get("json"), ",")[0]]
+		if !ok {
+			continue
+		}
+		envValue, ok := os.LookupEnv(envName)
+		if !ok {
+			continue
+		}
+
+		// the booleans and numbers are pointers, so that the value set from the environment is set even if it is zero
+		target := field
+		if field.Kind() == reflect.Pointer {
+			target = reflect.New(field.Type().Elem()).Elem()
+		}
+		var err error
+		switch target.Kind() {
+		case reflect.String:
+			target.SetString(envValue)
+		case reflect.Bool:
+			var b bool
+			b, err = strconv.ParseBool(envValue)
+			target.SetBool(b)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			var u uint64
+			u, err = strconv.ParseUint(envValue, 10, target.Type().Bits())
+			target.SetUint(u)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			var n int64
+			n, err = strconv.ParseInt(envValue, 10, target.Type().Bits())
+			target.SetInt(n)
+		default:
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("%s of kind %s cannot be set from the environment", structField.Name, target.Kind()), nil)
+		}
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid value '%s' of environment variable %s", envValue, envName), err)
+		}
+		if field.Kind() == reflect.Pointer {
+			field.Set(target.Addr())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package xrtmodels
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func opcuaServerComponent() Component {
+	return Component{
+		Category: OPCUAServerCategory,
+		Name:     "opcua-server",
+		Config: map[string]any{
+			Name:                                  "opcua-server",
+			RequestTopic:                          "xrt/request",
+			ReplyTopic:                            "xrt/reply",
+			OPCUAServerRequestTimeout:             float64(5000),
+			OPCUAServerUseTelemetryValues:         true,
+			OPCUAServerTopicMiddlewarePrefix:      "edgex",
+			OPCUAServerUseMiddlewarePrefixRequest: true,
+		},
+	}
+}
+
+func TestDecodeComponentConfig(t *testing.T) {
+	var config OPCUAServerConfig
+	require.NoError(t, DecodeComponentConfig(opcuaServerComponent(), &config))
+	assert.Equal(t, "xrt/request", config.RequestTopic)
+	require.NotNil(t, config.OPCUAServerRequestTimeout)
+	assert.Equal(t, uint(5000), *config.OPCUAServerRequestTimeout)
+	require.NotNil(t, config.OPCUAServerUseTelemetryValues)
+	assert.True(t, *config.OPCUAServerUseTelemetryValues)
+	assert.Nil(t, config.OPCUAServerUseMiddlewarePrefixReply)
+
+	configMap, err := ComponentConfigToMap(config)
+	require.NoError(t, err)
+	for key, value := range opcuaServerComponent().Config {
+		assert.Equal(t, value, configMap[key], key)
+	}
+	assert.NotContains(t, configMap, OPCUAServerUseMiddlewarePrefixReply, "the unset fields must not overwrite the component config")
+	assert.NotContains(t, configMap, EventTopic)
+
+	req, err := NewComponentConfigUpdateRequest("opcua-server", "test-client", config)
+	require.NoError(t, err)
+	assert.Equal(t, ComponentUpdateOperation, req.Op)
+	assert.Equal(t, configMap, req.Config)
+}
+
+func TestDecodeComponentConfig_ExtraKeys(t *testing.T) {
+	component := opcuaServerComponent()
+	component.Config["OPCUAServerMaxSessions"] = float64(10)
+	// the keys are matched case-sensitively, so a typo is kept aside rather than set into the declared field
+	component.Config["OPCUAServerRequestTimeOut"] = float64(1000)
+
+	var config OPCUAServerConfig
+	require.NoError(t, DecodeComponentConfig(component, &config))
+	assert.Equal(t, uint(5000), *config.OPCUAServerRequestTimeout)
+	assert.Equal(t, map[string]any{"OPCUAServerMaxSessions": float64(10), "OPCUAServerRequestTimeOut": float64(1000)}, config.Extra)
+
+	configMap, err := ComponentConfigToMap(config)
+	require.NoError(t, err)
+	assert.Equal(t, component.Config, configMap)
+}
+
+func TestDecodeComponentConfig_EnvOverrides(t *testing.T) {
+	t.Setenv(EnvXRTOPCUAServerRequestTimeout, "1000")
+	t.Setenv(EnvXRTOPCUAServerUseTelemetryValues, "false")
+	t.Setenv(EnvXRTOPCUAServerEdgeXEventTopicBase, "edgex/events")
+
+	var config OPCUAServerConfig
+	require.NoError(t, DecodeComponentConfig(opcuaServerComponent(), &config))
+	assert.Equal(t, uint(1000), *config.OPCUAServerRequestTimeout)
+	assert.False(t, *config.OPCUAServerUseTelemetryValues)
+	assert.Equal(t, "edgex/events", config.OPCUAServerEdgeXEventTopicBase)
+
+	// the false set from the environment must overwrite the true of the component when the config is updated
+	configMap, err := ComponentConfigToMap(config)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		Name:                                  "opcua-server",
+		RequestTopic:                          "xrt/request",
+		ReplyTopic:                            "xrt/reply",
+		OPCUAServerRequestTimeout:             float64(1000),
+		OPCUAServerUseTelemetryValues:         false,
+		OPCUAServerTopicMiddlewarePrefix:      "edgex",
+		OPCUAServerUseMiddlewarePrefixRequest: true,
+		OPCUAServerEdgeXEventTopicBase:        "edgex/events",
+	}, configMap)
+	req, err := NewComponentConfigUpdateRequest("opcua-server", "test-client", config)
+	require.NoError(t, err)
+	assert.Equal(t, false, req.Config[OPCUAServerUseTelemetryValues])
+
+	t.Setenv(EnvXRTMQTTBusQoS, "2")
+	t.Setenv(EnvXRTMQTTBusRetained, "true")
+	mqttBus := Component{Category: MQTTBusCategory, Name: "mqtt-bus", Config: map[string]any{MQTTBusUrl: "tcp://localhost:1883"}}
+	var mqttConfig MQTTBusConfig
+	require.NoError(t, DecodeComponentConfig(mqttBus, &mqttConfig))
+	assert.Equal(t, uint8(2), *mqttConfig.QoS)
+	assert.True(t, *mqttConfig.Retained)
+	assert.Nil(t, mqttConfig.CleanSession)
+}
+
+func TestDecodeComponentConfig_ZeroValues(t *testing.T) {
+	mqttBus := Component{Category: MQTTBusCategory, Name: "mqtt-bus", Config: map[string]any{
+		MQTTBusUrl: "tcp://localhost:1883", MQTTBusKeepAlive: float64(0), MQTTBusQoS: float64(0), MQTTBusRetained: false,
+	}}
+	var config MQTTBusConfig
+	require.NoError(t, DecodeComponentConfig(mqttBus, &config))
+	require.NotNil(t, config.KeepAlive)
+	assert.Zero(t, *config.KeepAlive)
+
+	// the explicit false and zero values are kept through the round trip, the unset CleanSession is left out
+	configMap, err := ComponentConfigToMap(config)
+	require.NoError(t, err)
+	assert.Equal(t, mqttBus.Config, configMap)
+}
+
+func TestDecodeComponentConfig_Error(t *testing.T) {
+	withConfig := func(key string, value any) Component {
+		component := opcuaServerComponent()
+		component.Config[key] = value
+		return component
+	}
+	tests := []struct {
+		name      string
+		component Component
+		env       map[string]string
+	}{
+		{"wrong type", withConfig(OPCUAServerUseTelemetryValues, "yes"), nil},
+		{"negative timeout", withConfig(OPCUAServerRequestTimeout, -1), nil},
+		{"missing request topic", withConfig(RequestTopic, ""), nil},
+		{"wrong category", Component{Category: DeviceServiceCategory, Name: "device-modbus"}, nil},
+		{"invalid env value", opcuaServerComponent(), map[string]string{EnvXRTOPCUAServerUseMiddlewarePrefixEvent: "yes"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			for key, value := range testCase.env {
+				t.Setenv(key, value)
+			}
+			var config OPCUAServerConfig
+			assert.Error(t, DecodeComponentConfig(testCase.component, &config))
+		})
+	}
+
+	var mqttConfig MQTTBusConfig
+	err := DecodeComponentConfig(Component{Category: MQTTBusCategory, Config: map[string]any{MQTTBusUrl: "tcp://localhost:1883", MQTTBusQoS: 3}}, &mqttConfig)
+	assert.Error(t, err)
+	_, err = NewComponentConfigUpdateRequest("mqtt-bus", "test-client", MQTTBusConfig{})
+	assert.Error(t, err)
+}
+
+func TestConfigEnvNames(t *testing.T) {
+	keys := configKeys(reflect.TypeOf(OPCUAServerConfig{}))
+	for key := range configKeys(reflect.TypeOf(MQTTBusConfig{})) {
+		keys[key] = struct{}{}
+	}
+	for key, envName := range configEnvNames {
+		assert.Contains(t, keys, key, "environment variable %s overrides an undeclared config key", envName)
+		assert.True(t, strings.HasPrefix(envName, "XRT_"), envName)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package xrtmodels
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// Message is the decoded form of a message published by XRT, exactly one of the pointer fields is set according to Type
+type Message struct {
+	Type            string
+	Telemetry       *MultiResourcesResult
+	Notification    *Notification
+	DeviceStatus    *DeviceStatus
+	DeviceDiscovery *DiscoveredDevicesResult
+	Discovery       *ComponentsDiscoveryResponse
+}
+
+// Decode decodes the message published by XRT according to its type. Messages of MessageTypeEvent carry either a
+// Notification, which has an event_type, or a DeviceStatus.
+func Decode(raw []byte) (Message, errors.EdgeX) {
+	var envelope struct {
+		Type      string `json:"type"`
+		EventType string `json:"event_type"`
+	}
+	if err := json.Unmarshal(raw, &envelope); err != nil {
+		return Message{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the XRT message", err)
+	}
+
+	message := Message{Type: envelope.Type}
+	var target any
+	switch envelope.Type {
+	case MessageTypeTelemetry:
+		message.Telemetry = &MultiResourcesResult{}
+		target = message.Telemetry
+	case MessageTypeEvent:
+		if envelope.EventType != "" {
+			message.Notification = &Notification{}
+			target = message.Notification
+		} else {
+			message.DeviceStatus = &DeviceStatus{}
+			target = message.DeviceStatus
+		}
+	case MessageTypeDeviceDiscovery:
+		message.DeviceDiscovery = &DiscoveredDevicesResult{}
+		target = message.DeviceDiscovery
+	case MessageTypeDiscovery:
+		message.Discovery = &ComponentsDiscoveryResponse{}
+		target = message.Discovery
+	default:
+		return Message{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported XRT message type '%s'", envelope.Type), nil)
+	}
+
+	if err := json.Unmarshal(raw, target); err != nil {
+		return Message{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to decode the %s message", envelope.Type), err)
+	}
+	return message, nil
+}
+
+// DeviceInfo decodes the device carried by a device:added or device:updated notification
+func (n Notification) DeviceInfo() (DeviceInfo, errors.EdgeX) {
+	var device DeviceInfo
+	if n.EventType != EventTypeDeviceAdded && n.EventType != EventTypeDeviceUpdated {
+		return device, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s notification does not carry a device", n.EventType), nil)
+	}
+	data, err := json.Marshal(n.Event)
+	if err != nil {
+		return device, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode the notification event", err)
+	}
+	if err = json.Unmarshal(data, &device); err != nil {
+		return device, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to decode the device of %s notification", n.EventType), err)
+	}
+	return device, nil
+}
+
+// MessageHandler handles a decoded XRT message
+type MessageHandler func(message Message) errors.EdgeX
+
+// MessageDispatcher decodes XRT messages and invokes the handler registered for the message type
+type MessageDispatcher struct {
+	mutex    sync.RWMutex
+	handlers map[string]MessageHandler
+}
+
+// NewMessageDispatcher creates a MessageDispatcher without handlers
+func NewMessageDispatcher() *MessageDispatcher {
+	return &MessageDispatcher{handlers: make(map[string]MessageHandler)}
+}
+
+// Register sets the handler of the message type, replacing any previous handler
+func (d *MessageDispatcher) Register(messageType string, handler MessageHandler) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.handlers[messageType] = handler
+}
+
+// RegisterEventHandler sets the handler of telemetry messages, which are converted to EdgeX events by ToEdgeXV2EventDTO
+func (d *MessageDispatcher) RegisterEventHandler(handler func(event dtos.Event) errors.EdgeX) {
+	d.Register(MessageTypeTelemetry, func(message Message) errors.EdgeX {
+		event, err := ToEdgeXV2EventDTO(*message.Telemetry)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to convert the telemetry of device %s", message.Telemetry.Device), err)
+		}
+		return handler(event)
+	})
+}
+
+// Dispatch decodes the raw message and invokes the handler of its type. Messages without a handler are ignored.
+func (d *MessageDispatcher) Dispatch(raw []byte) errors.EdgeX {
+	message, err := Decode(raw)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	d.mutex.RLock()
+	handler, ok := d.handlers[message.Type]
+	d.mutex.RUnlock()
+	if !ok {
+		return nil
+	}
+	return handler(message)
+}
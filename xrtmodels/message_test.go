@@ -0,0 +1,112 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package xrtmodels
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	telemetryMessage = `{"device":"sensor","profile":"sensor-profile","sourceName":"temperature","type":"xrt.telemetry:1.0",
+"readings":{"temperature":{"value":21.5,"type":"Float64","origin":1700000000000000000}}}`
+	deviceAddedMessage = `{"device_service":"device-modbus","event_type":"device:added","type":"xrt.event:1.0",
+"event":{"name":"sensor","profileName":"sensor-profile","protocols":{"modbus-tcp":{"Address":"127.0.0.1"}}}}`
+	deviceDeletedMessage  = `{"device_service":"device-modbus","event_type":"device:deleted","type":"xrt.event:1.0","event":"sensor"}`
+	deviceStatusMessage   = `{"device":"sensor","operational":false,"type":"xrt.event:1.0"}`
+	deviceDiscoveryResult = `{"type":"xrt.device.discovery:1.0","status":0,"devices":{"sensor":{"name":"sensor","profileName":"sensor-profile"}}}`
+	discoveryMessage      = `{"type":"xrt.discovery:1.0","node_id":"node","components":[{"name":"opcua-server","category":"XRT::OPCUAServer"}]}`
+)
+
+func TestDecode(t *testing.T) {
+	message, err := Decode([]byte(telemetryMessage))
+	require.NoError(t, err)
+	require.NotNil(t, message.Telemetry)
+	assert.Equal(t, "sensor", message.Telemetry.Device)
+	assert.Equal(t, 21.5, message.Telemetry.Readings["temperature"].Value)
+
+	message, err = Decode([]byte(deviceAddedMessage))
+	require.NoError(t, err)
+	require.NotNil(t, message.Notification)
+	assert.Nil(t, message.DeviceStatus)
+	device, err := message.Notification.DeviceInfo()
+	require.NoError(t, err)
+	assert.Equal(t, "sensor", device.Name)
+	assert.Equal(t, "127.0.0.1", device.Protocols["modbus-tcp"]["Address"])
+
+	message, err = Decode([]byte(deviceDeletedMessage))
+	require.NoError(t, err)
+	assert.Equal(t, EventTypeDeviceDeleted, message.Notification.EventType)
+	_, err = message.Notification.DeviceInfo()
+	assert.Error(t, err)
+
+	message, err = Decode([]byte(deviceStatusMessage))
+	require.NoError(t, err)
+	require.NotNil(t, message.DeviceStatus)
+	assert.False(t, message.DeviceStatus.Operational)
+
+	message, err = Decode([]byte(deviceDiscoveryResult))
+	require.NoError(t, err)
+	require.NotNil(t, message.DeviceDiscovery)
+	assert.Equal(t, "sensor-profile", message.DeviceDiscovery.Devices["sensor"].ProfileName)
+
+	message, err = Decode([]byte(discoveryMessage))
+	require.NoError(t, err)
+	require.NotNil(t, message.Discovery)
+	assert.Equal(t, "opcua-server", message.Discovery.Components[0].Name)
+}
+
+func TestDecode_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+	}{
+		{"not json", `foo`},
+		{"reply", `{"type":"xrt.reply:1.0"}`},
+		{"missing type", `{"device":"sensor"}`},
+		{"invalid telemetry", `{"type":"xrt.telemetry:1.0","readings":[]}`},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := Decode([]byte(testCase.raw))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestMessageDispatcher(t *testing.T) {
+	dispatcher := NewMessageDispatcher()
+
+	var events []dtos.Event
+	dispatcher.RegisterEventHandler(func(event dtos.Event) errors.EdgeX {
+		events = append(events, event)
+		return nil
+	})
+	var notifications []Notification
+	dispatcher.Register(MessageTypeEvent, func(message Message) errors.EdgeX {
+		if message.Notification == nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "not a notification", nil)
+		}
+		notifications = append(notifications, *message.Notification)
+		return nil
+	})
+
+	require.NoError(t, dispatcher.Dispatch([]byte(telemetryMessage)))
+	require.NoError(t, dispatcher.Dispatch([]byte(deviceAddedMessage)))
+	require.NoError(t, dispatcher.Dispatch([]byte(discoveryMessage)), "messages without a handler are ignored")
+	assert.Error(t, dispatcher.Dispatch([]byte(deviceStatusMessage)), "handler errors are returned")
+	assert.Error(t, dispatcher.Dispatch([]byte(`foo`)))
+
+	require.Len(t, events, 1)
+	assert.Equal(t, "sensor", events[0].DeviceName)
+	require.Len(t, events[0].Readings, 1)
+	assert.Equal(t, common.ValueTypeFloat64, events[0].Readings[0].ValueType)
+	require.Len(t, notifications, 1)
+	assert.Equal(t, EventTypeDeviceAdded, notifications[0].EventType)
+}
@@ -0,0 +1,230 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package xrtmodels
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// XRT schedule intervals are expressed in microseconds
+const scheduleIntervalUnit = time.Microsecond
+
+// ToXrtSchedules converts the AutoEvents of the device to XRT schedules. The profile is used to expand an AutoEvent
+// whose SourceName is a DeviceCommand to the resources of the command. The OnChangeThreshold becomes the bound of
+// every scheduled resource.
+func ToXrtSchedules(device dtos.Device, profile dtos.DeviceProfile) ([]Schedule, errors.EdgeX) {
+	schedules := make([]Schedule, len(device.AutoEvents))
+	for i, autoEvent := range device.AutoEvents {
+		resources, err := sourceResources(profile, autoEvent.SourceName)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to convert AutoEvent %d of device %s", i, device.Name), err)
+		}
+		interval, err := toXrtInterval(autoEvent.Interval)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to convert AutoEvent %d of device %s", i, device.Name), err)
+		}
+
+		schedule := Schedule{
+			Name:     fmt.Sprintf("%s-%s-%d", device.Name, autoEvent.SourceName, i),
+			Device:   device.Name,
+			Resource: resources,
+			Interval: interval,
+			OnChange: autoEvent.OnChange,
+			Publish:  true,
+		}
+		if autoEvent.OnChange && autoEvent.OnChangeThreshold > 0 {
+			schedule.Bounds = make(map[string]float64, len(resources))
+			for _, resource := range resources {
+				schedule.Bounds[resource] = autoEvent.OnChangeThreshold
+			}
+		}
+		schedules[i] = schedule
+	}
+	return schedules, nil
+}
+
+// ToEdgeXAutoEvent converts the XRT schedule to an AutoEvent. A schedule of several resources is mapped to the
+// DeviceCommand of the profile with exactly those resources, and the bounds must share a single threshold.
+func ToEdgeXAutoEvent(schedule Schedule, profile dtos.DeviceProfile) (dtos.AutoEvent, errors.EdgeX) {
+	sourceName, err := scheduleSourceName(schedule, profile)
+	if err != nil {
+		return dtos.AutoEvent{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	if schedule.Interval == 0 {
+		return dtos.AutoEvent{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("schedule %s has no interval", schedule.Name), nil)
+	}
+
+	autoEvent := dtos.AutoEvent{
+		Interval:   (time.Duration(schedule.Interval) * scheduleIntervalUnit).String(),
+		OnChange:   schedule.OnChange,
+		SourceName: sourceName,
+	}
+	if len(schedule.Bounds) > 0 {
+		if !schedule.OnChange {
+			return dtos.AutoEvent{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("schedule %s has bounds but is not on change", schedule.Name), nil)
+		}
+		first := true
+		for resource, bound := range schedule.Bounds {
+			if !slices.Contains(schedule.Resource, resource) {
+				return dtos.AutoEvent{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("schedule %s has a bound for resource %s which is not scheduled", schedule.Name, resource), nil)
+			}
+			if !first && bound != autoEvent.OnChangeThreshold {
+				return dtos.AutoEvent{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("schedule %s has different bounds per resource which cannot be mapped to a single OnChangeThreshold", schedule.Name), nil)
+			}
+			autoEvent.OnChangeThreshold = bound
+			first = false
+		}
+		if len(schedule.Bounds) != len(schedule.Resource) {
+			return dtos.AutoEvent{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("schedule %s only has bounds for some resources which cannot be mapped to a single OnChangeThreshold", schedule.Name), nil)
+		}
+	}
+
+	return autoEvent, nil
+}
+
+// FromScheduleJobToXrtSchedules converts an INTERVAL ScheduleJob to one XRT schedule per DeviceControlAction. The
+// profileByDevice function returns the profile of the device an action controls.
+func FromScheduleJobToXrtSchedules(job dtos.ScheduleJob, profileByDevice func(deviceName string) (dtos.DeviceProfile, errors.EdgeX)) ([]Schedule, errors.EdgeX) {
+	switch job.Definition.Type {
+	case common.DefInterval:
+	case common.DefCron:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("ScheduleJob %s has the cron definition '%s' which cannot be mapped to an XRT schedule interval", job.Name, job.Definition.Crontab), nil)
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("ScheduleJob %s has the unknown definition type '%s'", job.Name, job.Definition.Type), nil)
+	}
+	if job.Definition.StartTimestamp != 0 || job.Definition.EndTimestamp != 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("ScheduleJob %s has a start or end timestamp which XRT schedules do not support", job.Name), nil)
+	}
+	interval, err := toXrtInterval(job.Definition.Interval)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to convert ScheduleJob %s", job.Name), err)
+	}
+
+	schedules := make([]Schedule, len(job.Actions))
+	for i, action := range job.Actions {
+		if action.Type != common.ActionDeviceControl {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("action %d of ScheduleJob %s is a %s action, only %s actions can be mapped to XRT schedules", i, job.Name, action.Type, common.ActionDeviceControl), nil)
+		}
+		profile, err := profileByDevice(action.DeviceName)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to find the profile of device %s", action.DeviceName), err)
+		}
+		resources, err := sourceResources(profile, action.SourceName)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to convert action %d of ScheduleJob %s", i, job.Name), err)
+		}
+
+		name := job.Name
+		if len(job.Actions) > 1 {
+			name = fmt.Sprintf("%s-%d", job.Name, i)
+		}
+		schedules[i] = Schedule{
+			Name:     name,
+			Device:   action.DeviceName,
+			Resource: resources,
+			Interval: interval,
+			Publish:  true,
+		}
+	}
+	return schedules, nil
+}
+
+// ToEdgeXScheduleJob converts the XRT schedule to an INTERVAL ScheduleJob with a DeviceControlAction. On change
+// schedules cannot be converted because ScheduleJobs always execute.
+func ToEdgeXScheduleJob(schedule Schedule, profile dtos.DeviceProfile) (dtos.ScheduleJob, errors.EdgeX) {
+	if schedule.OnChange || len(schedule.Bounds) > 0 {
+		return dtos.ScheduleJob{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("schedule %s is on change which cannot be mapped to a ScheduleJob", schedule.Name), nil)
+	}
+	if schedule.Interval == 0 {
+		return dtos.ScheduleJob{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("schedule %s has no interval", schedule.Name), nil)
+	}
+	sourceName, err := scheduleSourceName(schedule, profile)
+	if err != nil {
+		return dtos.ScheduleJob{}, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	return dtos.ScheduleJob{
+		Name: schedule.Name,
+		Definition: dtos.ScheduleDef{
+			Type:                common.DefInterval,
+			IntervalScheduleDef: dtos.IntervalScheduleDef{Interval: (time.Duration(schedule.Interval) * scheduleIntervalUnit).String()},
+		},
+		Actions: []dtos.ScheduleAction{
+			{
+				Type:                common.ActionDeviceControl,
+				DeviceControlAction: dtos.DeviceControlAction{DeviceName: schedule.Device, SourceName: sourceName},
+			},
+		},
+		AdminState: models.Unlocked,
+	}, nil
+}
+
+// sourceResources returns the resources read by the source, which is either a DeviceResource or a DeviceCommand
+func sourceResources(profile dtos.DeviceProfile, sourceName string) ([]string, errors.EdgeX) {
+	for _, resource := range profile.DeviceResources {
+		if resource.Name == sourceName {
+			return []string{resource.Name}, nil
+		}
+	}
+	for _, command := range profile.DeviceCommands {
+		if command.Name == sourceName {
+			resources := make([]string, len(command.ResourceOperations))
+			for i, ro := range command.ResourceOperations {
+				resources[i] = ro.DeviceResource
+			}
+			return resources, nil
+		}
+	}
+	return nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("source %s is neither a DeviceResource nor a DeviceCommand of profile %s", sourceName, profile.Name), nil)
+}
+
+// scheduleSourceName returns the resource or the DeviceCommand that reads exactly the resources of the schedule
+func scheduleSourceName(schedule Schedule, profile dtos.DeviceProfile) (string, errors.EdgeX) {
+	switch len(schedule.Resource) {
+	case 0:
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("schedule %s has no resource", schedule.Name), nil)
+	case 1:
+		if _, err := sourceResources(profile, schedule.Resource[0]); err != nil {
+			return "", errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to convert schedule %s", schedule.Name), err)
+		}
+		return schedule.Resource[0], nil
+	}
+
+	for _, command := range profile.DeviceCommands {
+		if len(command.ResourceOperations) != len(schedule.Resource) {
+			continue
+		}
+		matched := true
+		for _, ro := range command.ResourceOperations {
+			if !slices.Contains(schedule.Resource, ro.DeviceResource) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return command.Name, nil
+		}
+	}
+	return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("schedule %s reads %v which is not the resource set of any DeviceCommand of profile %s", schedule.Name, schedule.Resource, profile.Name), nil)
+}
+
+func toXrtInterval(interval string) (uint64, errors.EdgeX) {
+	duration, err := time.ParseDuration(interval)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid interval '%s'", interval), err)
+	}
+	if duration < scheduleIntervalUnit {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("interval '%s' is shorter than the XRT schedule resolution of %s", interval, scheduleIntervalUnit), nil)
+	}
+	if duration%scheduleIntervalUnit != 0 {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("interval '%s' is not a whole number of %s", interval, scheduleIntervalUnit), nil)
+	}
+	return uint64(duration / scheduleIntervalUnit), nil
+}
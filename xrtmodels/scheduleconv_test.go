@@ -0,0 +1,146 @@
+// Copyright (C) 2026 IOTech Ltd
+
+package xrtmodels
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func scheduleTestProfile() dtos.DeviceProfile {
+	return dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "test-profile"},
+		DeviceResources: []dtos.DeviceResource{
+			{Name: "temperature"}, {Name: "humidity"}, {Name: "pressure"},
+		},
+		DeviceCommands: []dtos.DeviceCommand{
+			{Name: "climate", ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "temperature"}, {DeviceResource: "humidity"}}},
+		},
+	}
+}
+
+func TestAutoEventScheduleConversion(t *testing.T) {
+	profile := scheduleTestProfile()
+	device := dtos.Device{
+		Name: "test-device",
+		AutoEvents: []dtos.AutoEvent{
+			{Interval: "1s", SourceName: "pressure"},
+			{Interval: "500ms", OnChange: true, OnChangeThreshold: 0.5, SourceName: "climate"},
+		},
+	}
+
+	schedules, err := ToXrtSchedules(device, profile)
+	require.NoError(t, err)
+	require.Len(t, schedules, 2)
+	assert.Equal(t, "test-device", schedules[0].Device)
+	assert.Equal(t, []string{"pressure"}, schedules[0].Resource)
+	assert.Equal(t, uint64(1000000), schedules[0].Interval)
+	assert.False(t, schedules[0].OnChange)
+	assert.Nil(t, schedules[0].Bounds)
+	assert.Equal(t, []string{"temperature", "humidity"}, schedules[1].Resource)
+	assert.Equal(t, uint64(500000), schedules[1].Interval)
+	assert.True(t, schedules[1].OnChange)
+	assert.Equal(t, map[string]float64{"temperature": 0.5, "humidity": 0.5}, schedules[1].Bounds)
+
+	for i, schedule := range schedules {
+		autoEvent, err := ToEdgeXAutoEvent(schedule, profile)
+		require.NoError(t, err)
+		assert.Equal(t, device.AutoEvents[i], autoEvent)
+	}
+}
+
+func TestAutoEventScheduleConversion_Error(t *testing.T) {
+	profile := scheduleTestProfile()
+	autoEventTests := []struct {
+		name      string
+		autoEvent dtos.AutoEvent
+	}{
+		{"unknown source", dtos.AutoEvent{Interval: "1s", SourceName: "unknown"}},
+		{"invalid interval", dtos.AutoEvent{Interval: "1 second", SourceName: "pressure"}},
+		{"interval below resolution", dtos.AutoEvent{Interval: "100ns", SourceName: "pressure"}},
+	}
+	for _, testCase := range autoEventTests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := ToXrtSchedules(dtos.Device{Name: "test-device", AutoEvents: []dtos.AutoEvent{testCase.autoEvent}}, profile)
+			assert.Error(t, err)
+		})
+	}
+
+	scheduleTests := []struct {
+		name     string
+		schedule Schedule
+	}{
+		{"no resource", Schedule{Interval: 1000}},
+		{"no interval", Schedule{Resource: []string{"pressure"}}},
+		{"no matching command", Schedule{Interval: 1000, Resource: []string{"pressure", "humidity"}}},
+		{"bounds without on change", Schedule{Interval: 1000, Resource: []string{"pressure"}, Bounds: map[string]float64{"pressure": 1}}},
+		{"different bounds", Schedule{Interval: 1000, OnChange: true, Resource: []string{"temperature", "humidity"}, Bounds: map[string]float64{"temperature": 1, "humidity": 2}}},
+		{"partial bounds", Schedule{Interval: 1000, OnChange: true, Resource: []string{"temperature", "humidity"}, Bounds: map[string]float64{"temperature": 1}}},
+		{"bound of other resource", Schedule{Interval: 1000, OnChange: true, Resource: []string{"pressure"}, Bounds: map[string]float64{"humidity": 1}}},
+	}
+	for _, testCase := range scheduleTests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := ToEdgeXAutoEvent(testCase.schedule, profile)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestScheduleJobConversion(t *testing.T) {
+	profile := scheduleTestProfile()
+	profileByDevice := func(deviceName string) (dtos.DeviceProfile, errors.EdgeX) {
+		if deviceName != "test-device" {
+			return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device not found", nil)
+		}
+		return profile, nil
+	}
+	job := dtos.ScheduleJob{
+		Name: "test-job",
+		Definition: dtos.ScheduleDef{
+			Type:                common.DefInterval,
+			IntervalScheduleDef: dtos.IntervalScheduleDef{Interval: "10s"},
+		},
+		Actions: []dtos.ScheduleAction{
+			{Type: common.ActionDeviceControl, DeviceControlAction: dtos.DeviceControlAction{DeviceName: "test-device", SourceName: "climate"}},
+		},
+	}
+
+	schedules, err := FromScheduleJobToXrtSchedules(job, profileByDevice)
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+	assert.Equal(t, "test-job", schedules[0].Name)
+	assert.Equal(t, uint64(10000000), schedules[0].Interval)
+	assert.Equal(t, []string{"temperature", "humidity"}, schedules[0].Resource)
+
+	result, err := ToEdgeXScheduleJob(schedules[0], profile)
+	require.NoError(t, err)
+	assert.Equal(t, job.Name, result.Name)
+	assert.Equal(t, job.Definition, result.Definition)
+	assert.Equal(t, job.Actions, result.Actions)
+
+	cronJob := job
+	cronJob.Definition = dtos.ScheduleDef{Type: common.DefCron, CronScheduleDef: dtos.CronScheduleDef{Crontab: "0 * * * *"}}
+	_, err = FromScheduleJobToXrtSchedules(cronJob, profileByDevice)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cron definition '0 * * * *'")
+
+	restJob := job
+	restJob.Actions = []dtos.ScheduleAction{{Type: common.ActionREST}}
+	_, err = FromScheduleJobToXrtSchedules(restJob, profileByDevice)
+	assert.Error(t, err)
+
+	unknownDeviceJob := job
+	unknownDeviceJob.Actions = []dtos.ScheduleAction{{Type: common.ActionDeviceControl, DeviceControlAction: dtos.DeviceControlAction{DeviceName: "unknown", SourceName: "climate"}}}
+	_, err = FromScheduleJobToXrtSchedules(unknownDeviceJob, profileByDevice)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+
+	_, err = ToEdgeXScheduleJob(Schedule{Name: "on-change", Interval: 1000, OnChange: true, Resource: []string{"pressure"}}, profile)
+	assert.Error(t, err)
+}
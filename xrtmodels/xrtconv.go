@@ -5,89 +5,24 @@ package xrtmodels
 import (
 	"encoding/base64"
 	"fmt"
-	"strconv"
 
-	"github.com/edgexfoundry/go-mod-core-contracts/v4/central/dbc"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 )
 
 func toEdgeXProperties(protocol string, protocolProperties map[string]any) map[string]string {
-	intProperties, floatProperties, boolProperties := PropertyConversionList(protocol)
-
-	edgexProperties := make(map[string]string)
-	for k, v := range protocolProperties {
-		edgexProperties[k] = fmt.Sprintf("%v", v)
-	}
-
-	for _, p := range intProperties {
-		propertyValue, ok := protocolProperties[p]
-		if ok {
-			// if we use fmt.fmt.Sprintf("%v", propertyValue) to convert the float to string,
-			// the 4194148 become 4.194148e+06 and dot(.), plus(+) are invalid for metadata
-			// so we can use %.0f to convert the float without the decimal point
-			edgexProperties[p] = fmt.Sprintf("%.0f", propertyValue)
-		}
-	}
-
-	for _, p := range floatProperties {
-		propertyValue, ok := protocolProperties[p]
-		if ok {
-			switch val := propertyValue.(type) {
-			case float64:
-				// The -1 as the third parameter tells the function to print the fewest digits necessary to accurately represent the float
-				// For example:
-				//   strconv.FormatFloat(5.2, 'f', -1, 64) -> 5.2
-				//   fmt.Sprintf("%f",5.2) -> 5.200000
-				edgexProperties[p] = strconv.FormatFloat(val, 'f', -1, 64)
-			}
-		}
-	}
-
-	for _, p := range boolProperties {
-		propertyValue, ok := protocolProperties[p]
-		if ok {
-			edgexProperties[p] = fmt.Sprintf("%v", propertyValue)
-		}
-	}
-	return edgexProperties
+	schema, _ := common.LookupProtocolSchema(protocol)
+	return schema.ToStringProperties(protocolProperties)
 }
 
+// PropertyConversionList returns the int, float and bool properties of the protocol from its registered schema
+//
+// Deprecated: use common.LookupProtocolSchema instead
 func PropertyConversionList(protocol string) ([]string, []string, []string) {
-	var intProperties []string
-	var floatProperties []string
-	var boolProperties []string
-	switch protocol {
-	case common.BacnetIP, common.BacnetMSTP:
-		intProperties = []string{common.BacnetDeviceInstance, common.BacnetPort}
-	case common.Gps:
-		intProperties = []string{common.GpsGpsdPort, common.GpsGpsdRetries, common.GpsGpsdConnTimeout, common.GpsGpsdRequestTimeout}
-	case common.ModbusTcp:
-		intProperties = []string{common.ModbusUnitID, common.ModbusPort, common.ModbusReadMaxHoldingRegisters,
-			common.ModbusReadMaxInputRegisters, common.ModbusReadMaxBitsCoils, common.ModbusReadMaxBitsDiscreteInputs,
-			common.ModbusWriteMaxHoldingRegisters, common.ModbusWriteMaxBitsCoils}
-	case common.ModbusRtu:
-		intProperties = []string{common.ModbusUnitID, common.ModbusBaudRate, common.ModbusDataBits, common.ModbusStopBits,
-			common.ModbusReadMaxHoldingRegisters, common.ModbusReadMaxInputRegisters, common.ModbusReadMaxBitsCoils,
-			common.ModbusReadMaxBitsDiscreteInputs, common.ModbusWriteMaxHoldingRegisters, common.ModbusWriteMaxBitsCoils}
-	case common.Opcua:
-		intProperties = []string{common.OpcuaRequestedSessionTimeout, common.OpcuaBrowseDepth, common.OpcuaConnectionReadingPostDelay, common.OpcuaReadBatchSize, common.OpcuaWriteBatchSize, common.OpcuaNodesPerBrowse}
-		floatProperties = []string{common.OpcuaBrowsePublishInterval, common.OpcuaSessionKeepAliveInterval}
-	case common.S7:
-		intProperties = []string{common.S7Rack, common.S7Slot}
-	case common.EtherNetIPExplicitConnected:
-		intProperties = []string{common.EtherNetIPRPI}
-		boolProperties = []string{common.EtherNetIPSaveValue}
-	case common.EtherNetIPO2T, common.EtherNetIPT2O:
-		intProperties = []string{common.EtherNetIPRPI}
-	case common.EtherNetIPKey:
-		intProperties = []string{common.EtherNetIPVendorID, common.EtherNetIPDeviceType, common.EtherNetIPProductCode,
-			common.EtherNetIPMajorRevision, common.EtherNetIPMinorRevision}
-	case dbc.Canbus:
-		intProperties = []string{dbc.ID, dbc.DataSize, dbc.Port}
-	}
-	return intProperties, floatProperties, boolProperties
+	schema, _ := common.LookupProtocolSchema(protocol)
+	return schema.PropertiesOfType(common.ProtocolPropertyTypeInt), schema.PropertiesOfType(common.ProtocolPropertyTypeFloat),
+		schema.PropertiesOfType(common.ProtocolPropertyTypeBool)
 }
 
 func ToEdgeXV2EventDTO(xrtEvent MultiResourcesResult) (dtos.Event, errors.EdgeX) {